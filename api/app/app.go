@@ -1,8 +1,15 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/deikioveca/TheRedDevilsData/api/database"
 	"github.com/deikioveca/TheRedDevilsData/api/football_client"
@@ -22,7 +29,11 @@ type App struct {
 
 func NewApp() *App {
 	db 		:= database.InitDB()
-	client 	:= football_client.NewFootballClient(db)
+	client, err := football_client.NewFootballClient(db)
+	if err != nil {
+		log.Fatalf("failed to create football client: %v", err)
+	}
+
 	service := service.NewService(db, client)
 	handler := handler.NewHandler(service)
 
@@ -43,31 +54,85 @@ func (a *App) Run() {
 
 	mux.HandleFunc("GET /league", a.Handler.GetLeagues)
 
-	mux.HandleFunc("GET /team", a.Handler.GetTeam)
-
-	mux.HandleFunc("GET /teamStats/games/{season}", 		a.Handler.GetTeamStatsGames)
-	mux.HandleFunc("GET /teamStats/goals/{season}", 		a.Handler.GetTeamStatsGoals)
-	mux.HandleFunc("GET /teamStats/streak/{season}", 		a.Handler.GetTeamStatsStreak)
-	mux.HandleFunc("GET /teamStats/biggest/{season}", 		a.Handler.GetTeamStatsBiggest)
-	mux.HandleFunc("GET /teamStats/cleansheet/{season}", 	a.Handler.GetTeamStatsCleanSheet)
-	mux.HandleFunc("GET /teamStats/failedtoscore/{season}", a.Handler.GetTeamStatsFailedToScore)
-	mux.HandleFunc("GET /teamStats/penalty/{season}", 		a.Handler.GetTeamStatsPenalty)
-	mux.HandleFunc("GET /teamStats/cards/{season}", 		a.Handler.GetTeamStatsCards)
-	mux.HandleFunc("GET /teamStats/lineup/{season}", 		a.Handler.GetTeamStatsLineups)
+	mux.HandleFunc("GET /team", 			a.Handler.GetTeam)
+	mux.HandleFunc("GET /team/trophies", 	a.Handler.GetTeamTrophies)
+
+	mux.HandleFunc("GET /teamStats/games/{season}", 			a.Handler.GetTeamStatsGames)
+	mux.HandleFunc("GET /teamStats/goals/{season}", 			a.Handler.GetTeamStatsGoals)
+	mux.HandleFunc("GET /teamStats/goals/{season}/minutes", 	a.Handler.GetTeamStatsGoalsMinutes)
+	mux.HandleFunc("GET /teamStats/goals/{season}/underover", 	a.Handler.GetTeamStatsGoalsUnderOver)
+	mux.HandleFunc("GET /teamStats/streak/{season}", 			a.Handler.GetTeamStatsStreak)
+	mux.HandleFunc("GET /teamStats/biggest/{season}", 			a.Handler.GetTeamStatsBiggest)
+	mux.HandleFunc("GET /teamStats/cleansheet/{season}", 		a.Handler.GetTeamStatsCleanSheet)
+	mux.HandleFunc("GET /teamStats/failedtoscore/{season}", 	a.Handler.GetTeamStatsFailedToScore)
+	mux.HandleFunc("GET /teamStats/penalty/{season}", 			a.Handler.GetTeamStatsPenalty)
+	mux.HandleFunc("GET /teamStats/cards/{season}", 			a.Handler.GetTeamStatsCards)
+	mux.HandleFunc("GET /teamStats/cards/{season}/minutes", 	a.Handler.GetTeamStatsCardsMinutes)
+	mux.HandleFunc("GET /teamStats/lineup/{season}", 			a.Handler.GetTeamStatsLineups)
+	mux.HandleFunc("GET /teamStats/matches/{season}", 			a.Handler.GetTeamStatsMatches)
+	mux.HandleFunc("GET /teamStats/compare", 					a.Handler.GetTeamStatsComparison)
 
 	mux.HandleFunc("GET /venue", 					a.Handler.GetVenues)
 	mux.HandleFunc("GET /venue/{city}", 			a.Handler.GetVenuesByCity)
 	mux.HandleFunc("GET /venue/biggest&smallest", 	a.Handler.GetVenuesBiggestAndSmallest)
 
-	mux.HandleFunc("GET /standings/{season}", a.Handler.GetStandingsBySeason)
+	mux.HandleFunc("GET /standings/{season}", 				a.Handler.GetStandingsBySeason)
+	mux.HandleFunc("GET /standings/{season}/progression", 	a.Handler.GetStandingsProgression)
 
-	mux.HandleFunc("GET /fixtures/{season}", a.Handler.GetFixturesBySeason)
+	mux.HandleFunc("GET /fixtures", 				a.Handler.GetFixtures)
+	mux.HandleFunc("GET /fixtures/{season}", 		a.Handler.GetFixturesBySeason)
+	mux.HandleFunc("GET /fixtures/{id}/events", 	a.Handler.GetFixtureEvents)
+	mux.HandleFunc("GET /fixtures/{id}/lineups", 	a.Handler.GetFixtureLineups)
+	mux.HandleFunc("GET /fixtures/{id}/statistics", a.Handler.GetFixtureStatistics)
+
+	mux.HandleFunc("GET /h2h/{opponentTeamID}", a.Handler.GetHeadToHead)
 
 	mux.HandleFunc("GET /injuries/{season}", a.Handler.GetInjuriesBySeason)
 
+	mux.HandleFunc("GET /season/{season}/summary", a.Handler.GetSeasonSummary)
+
 	mux.HandleFunc("GET /squad", a.Handler.GetSquad)
 
-	if err := http.ListenAndServe(":8080", mux); err != nil {
+	mux.HandleFunc("GET /transfers", a.Handler.GetTransfers)
+
+	mux.HandleFunc("GET /coaches", 		a.Handler.GetCoaches)
+	mux.HandleFunc("GET /coaches/{id}", a.Handler.GetCoach)
+
+	mux.HandleFunc("GET /players", 							a.Handler.GetPlayers)
+	mux.HandleFunc("GET /players/{id}", 					a.Handler.GetPlayer)
+	mux.HandleFunc("GET /players/{id}/stats/{season}", 		a.Handler.GetPlayerStats)
+	mux.HandleFunc("GET /players/{id}/appearances", 		a.Handler.GetPlayerAppearances)
+	mux.HandleFunc("GET /players/{id}/trophies", 			a.Handler.GetPlayerTrophies)
+	mux.HandleFunc("GET /players/{id}/sidelined", 			a.Handler.GetPlayerSidelined)
+
+	mux.HandleFunc("GET /leaderboards/{season}/{metric}", a.Handler.GetLeaderboard)
+
+	mux.HandleFunc("GET /trackedTeams", 	a.Handler.GetTrackedTeams)
+	mux.HandleFunc("POST /trackedTeams", 	a.Handler.TrackTeam)
+
+	mux.HandleFunc("GET /quota", a.Handler.GetAPIQuota)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := &http.Server{
+		Addr: 			":8080",
+		Handler: 		mux,
+		BaseContext: 	func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10 * time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("failed to shut down server: %v", err)
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("failed to start server: %v", err)
 	}
 }
\ No newline at end of file
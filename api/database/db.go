@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/deikioveca/TheRedDevilsData/api/model"
 	"github.com/joho/godotenv"
@@ -31,7 +32,88 @@ func InitDB() *gorm.DB {
 		log.Fatalf("failed to connect database: %v", err)
 	}
 
-	db.AutoMigrate(&model.Country{}, &model.League{}, &model.Team{}, &model.TeamStats{}, &model.Venue{}, &model.Standing{}, &model.Fixture{}, &model.Injury{}, &model.Squad{}, &model.Lineup{})
+	if err := Migrate(db); err != nil {
+		log.Fatalf("failed to migrate database: %v", err)
+	}
+
+	seedTrackedTeams(db)
 
 	return db
+}
+
+
+var models = []any{&model.Country{}, &model.League{}, &model.Team{}, &model.TeamStats{}, &model.Venue{}, &model.Standing{}, &model.Fixture{}, &model.HeadToHeadMeeting{}, &model.Injury{}, &model.Squad{}, &model.Lineup{}, &model.TeamGoalsMinute{}, &model.TeamGoalsUnderOver{}, &model.TeamCardsMinute{}, &model.TrackedTeam{}, &model.APIQuota{}, &model.FixtureEvent{}, &model.FixtureLineup{}, &model.FixtureLineupPlayer{}, &model.FixtureStatistics{}, &model.Player{}, &model.PlayerSeasonStats{}, &model.LeaderboardEntry{}, &model.Transfer{}, &model.Coach{}, &model.CoachCareer{}, &model.Trophy{}, &model.Sidelined{}}
+
+
+// Migrate clears duplicate natural keys and creates or updates every table.
+func Migrate(db *gorm.DB) error {
+	for _, m := range models {
+		if err := dropDuplicates(db, m); err != nil {
+			return err
+		}
+	}
+
+	return db.AutoMigrate(models...)
+}
+
+
+// dropDuplicates keeps the newest row of every natural key, so the unique
+// indexes the upserts rely on can be created on tables filled before them.
+func dropDuplicates(db *gorm.DB, m any) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(m) {
+		return nil
+	}
+
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(m); err != nil {
+		return err
+	}
+
+	for _, index := range stmt.Schema.ParseIndexes() {
+		if index.Class != "UNIQUE" || migrator.HasIndex(m, index.Name) {
+			continue
+		}
+
+		var conditions []string
+		for _, option := range index.Fields {
+			if !migrator.HasColumn(m, option.DBName) {
+				conditions = nil
+				break
+			}
+			conditions = append(conditions, fmt.Sprintf("a.%[1]s = b.%[1]s", option.DBName))
+		}
+		if len(conditions) == 0 {
+			continue
+		}
+
+		table 	:= stmt.Schema.Table
+		id 		:= stmt.Schema.PrioritizedPrimaryField.DBName
+		result 	:= db.Exec(fmt.Sprintf("DELETE FROM %[1]s a USING %[1]s b WHERE a.%[2]s < b.%[2]s AND %[3]s", table, id, strings.Join(conditions, " AND ")))
+		if result.Error != nil {
+			return fmt.Errorf("failed to remove duplicates from %s: %w", table, result.Error)
+		}
+		if result.RowsAffected > 0 {
+			log.Printf("removed %d duplicate rows from %s before creating %s", result.RowsAffected, table, index.Name)
+		}
+	}
+
+	return nil
+}
+
+
+func seedTrackedTeams(db *gorm.DB) {
+	var count int64
+	if err := db.Model(&model.TrackedTeam{}).Count(&count).Error; err != nil {
+		log.Fatalf("failed to read tracked teams: %v", err)
+	}
+
+	if count > 0 {
+		return
+	}
+
+	defaultTeam := model.DefaultTrackedTeam
+	if err := db.Create(&defaultTeam).Error; err != nil {
+		log.Fatalf("failed to seed default tracked team: %v", err)
+	}
 }
\ No newline at end of file
@@ -0,0 +1,212 @@
+package football_client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+)
+
+var (
+	ErrCassetteMiss = errors.New("no recorded response for request")
+
+	ErrInvalidCassetteMode = errors.New("cassette mode must be one of: live, record, replay")
+)
+
+
+type CassetteMode string
+
+const (
+	CassetteLive	CassetteMode = "live"
+	CassetteRecord	CassetteMode = "record"
+	CassetteReplay	CassetteMode = "replay"
+)
+
+
+const (
+	defaultCassetteDir = "cassettes"
+
+	// replayBaseUrl stands in for an unset BASE_URL in replay mode, the request
+	// url needs a host so the endpoint stays in its path.
+	replayBaseUrl = "http://cassette.invalid"
+)
+
+
+func ParseCassetteMode(value string) (CassetteMode, error) {
+	switch CassetteMode(strings.ToLower(value)) {
+	case "", CassetteLive:
+		return CassetteLive, nil
+	case CassetteRecord:
+		return CassetteRecord, nil
+	case CassetteReplay:
+		return CassetteReplay, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidCassetteMode, value)
+	}
+}
+
+
+type cassetteEntry struct {
+	Request		string		`json:"request"`
+	StatusCode	int			`json:"status_code"`
+	Header		http.Header	`json:"header"`
+	Body		string		`json:"body"`
+}
+
+
+type cassetteTransport struct {
+	mode		CassetteMode
+	dir			string
+	basePath	string
+	next		http.RoundTripper
+}
+
+
+func newCassetteTransport(mode CassetteMode, dir, baseUrl string, next http.RoundTripper) *cassetteTransport {
+	var basePath string
+	if u, err := url.Parse(baseUrl); err == nil {
+		basePath = strings.TrimRight(u.Path, "/")
+	}
+	return &cassetteTransport{mode: mode, dir: dir, basePath: basePath, next: next}
+}
+
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := cassetteKey(req, t.basePath)
+	path := filepath.Join(t.dir, cassetteFileName(key))
+
+	if t.mode == CassetteReplay {
+		return t.replay(req, key, path)
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordable(res.StatusCode, body) {
+		entry := cassetteEntry{
+			Request: 		key,
+			StatusCode: 	res.StatusCode,
+			Header: 		res.Header,
+			Body: 			string(body),
+		}
+		if err := writeCassette(path, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+
+// recordable keeps transient failures out of the cassette, a retry overwrites
+// the same file and replay would then serve the failure forever. API-Football
+// reports its rate limits with a 200 status and an errors payload.
+func recordable(statusCode int, body []byte) bool {
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return false
+	}
+
+	var envelope model.APIEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return true
+	}
+
+	err := parseAPIErrors("", envelope.Errors)
+	return !errors.Is(err, ErrRateLimited) && !errors.Is(err, ErrDailyLimitReached)
+}
+
+
+func (t *cassetteTransport) replay(req *http.Request, key, path string) (*http.Response, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrCassetteMiss, key)
+		}
+		return nil, err
+	}
+
+	var entry cassetteEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, fmt.Errorf("failed to read cassette %s: %w", path, err)
+	}
+
+	return &http.Response{
+		Status: 		fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode: 	entry.StatusCode,
+		Proto: 			"HTTP/1.1",
+		ProtoMajor: 	1,
+		ProtoMinor: 	1,
+		Header: 		entry.Header,
+		Body: 			io.NopCloser(strings.NewReader(entry.Body)),
+		ContentLength: 	int64(len(entry.Body)),
+		Request: 		req,
+	}, nil
+}
+
+
+func writeCassette(path string, entry cassetteEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+
+// cassetteKey identifies a request by endpoint and sorted query parameters only,
+// so recordings don't depend on the base url, its path or the api key.
+func cassetteKey(req *http.Request, basePath string) string {
+	endpoint := strings.TrimPrefix(req.URL.Path, basePath)
+	endpoint = strings.Trim(path.Clean("/" + endpoint), "/")
+
+	query := req.URL.Query().Encode()
+	if query == "" {
+		return endpoint
+	}
+	return endpoint + "?" + query
+}
+
+
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+
+const maxReadableFileName = 100
+
+
+// cassetteFileName keeps a readable prefix for browsing the directory, the
+// hash of the full key is what tells two requests apart.
+func cassetteFileName(key string) string {
+	readable := unsafeFileChars.ReplaceAllString(key, "_")
+	if len(readable) > maxReadableFileName {
+		readable = readable[:maxReadableFileName]
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return readable + "-" + hex.EncodeToString(sum[:8]) + ".json"
+}
\ No newline at end of file
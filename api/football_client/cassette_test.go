@@ -0,0 +1,179 @@
+package football_client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+
+func TestCassetteKey(t *testing.T) {
+	tests := []struct {
+		name		string
+		baseUrl		string
+		endpoint	string
+		want		string
+	}{
+		{"host only", "https://v3.football.api-sports.io", "/fixtures?season=2023&league=39", "fixtures?league=39&season=2023"},
+		{"base path", "https://api-football-v1.p.rapidapi.com/v3", "/fixtures?league=39&season=2023", "fixtures?league=39&season=2023"},
+		{"base path with a trailing slash", "http://127.0.0.1:8090/api/", "/fixtures?league=39&season=2023", "fixtures?league=39&season=2023"},
+		{"nested endpoint", "https://api-football-v1.p.rapidapi.com/v3", "/fixtures/headtohead?h2h=33-40", "fixtures/headtohead?h2h=33-40"},
+		{"no query", "https://api-football-v1.p.rapidapi.com/v3", "/countries", "countries"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.baseUrl + "/" + tt.endpoint, nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+
+			transport := newCassetteTransport(CassetteRecord, "", tt.baseUrl, nil)
+			if got := cassetteKey(req, transport.basePath); got != tt.want {
+				t.Errorf("cassetteKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestCassetteFileNameKeepsKeysApart(t *testing.T) {
+	keys := []string{
+		"players?id=1&season=2",
+		"players?id=1_season=2",
+		"players/id=1&season=2",
+		"players_id_1_season_2",
+	}
+
+	seen := make(map[string]string)
+	for _, key := range keys {
+		name := cassetteFileName(key)
+		if other, ok := seen[name]; ok {
+			t.Errorf("cassetteFileName(%q) = cassetteFileName(%q) = %q", key, other, name)
+		}
+		seen[name] = key
+	}
+}
+
+
+// cassetteServer serves a fixed body for every path and counts the requests
+// that reached it, replay must not add to them.
+func cassetteServer(t *testing.T, status int, body string) (*httptest.Server, func() int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+
+func cassetteClient(t *testing.T, mode CassetteMode, dir, baseUrl string) FootballClient {
+	t.Helper()
+
+	t.Setenv("BASE_URL", baseUrl)
+	t.Setenv("FOOTBALL_CASSETTE_MODE", "")
+	t.Setenv("API_RETRY_BASE_DELAY", "1ms")
+	t.Setenv("API_RETRY_MAX_DELAY", "5ms")
+
+	client, err := NewFootballClient(nil, WithCassette(mode, dir))
+	if err != nil {
+		t.Fatalf("NewFootballClient() error = %v", err)
+	}
+	return client
+}
+
+
+func TestCassetteRecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+	srv, requests := cassetteServer(t, http.StatusOK, `{"errors": [], "response": [{"name": "England", "code": "GB"}]}`)
+
+	recorded, err := cassetteClient(t, CassetteRecord, dir, srv.URL + "/v3").FetchCountries(context.Background())
+	if err != nil {
+		t.Fatalf("FetchCountries() record error = %v", err)
+	}
+
+	for _, baseUrl := range []string{"", "https://elsewhere.example/other"} {
+		t.Run("base url "+baseUrl, func(t *testing.T) {
+			replayed, err := cassetteClient(t, CassetteReplay, dir, baseUrl).FetchCountries(context.Background())
+			if err != nil {
+				t.Fatalf("FetchCountries() replay error = %v", err)
+			}
+
+			if len(replayed.Response) != 1 || replayed.Response[0] != recorded.Response[0] {
+				t.Errorf("replayed %v, want %v", replayed.Response, recorded.Response)
+			}
+		})
+	}
+
+	if got := requests(); got != 1 {
+		t.Errorf("server saw %d requests, want 1", got)
+	}
+}
+
+
+func TestCassetteMiss(t *testing.T) {
+	client := cassetteClient(t, CassetteReplay, t.TempDir(), "")
+
+	start := time.Now()
+	_, err := client.FetchCountries(context.Background())
+	if !errors.Is(err, ErrCassetteMiss) {
+		t.Fatalf("FetchCountries() error = %v, want %v", err, ErrCassetteMiss)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("FetchCountries() took %v, a cassette miss must not be retried", elapsed)
+	}
+}
+
+
+func TestCassetteSkipsUnsuccessfulResponses(t *testing.T) {
+	tests := []struct {
+		name	string
+		status	int
+		body	string
+	}{
+		{"server error", http.StatusInternalServerError, `{"errors": [], "response": []}`},
+		{"too many requests", http.StatusTooManyRequests, ""},
+		{"rate limited in the envelope", http.StatusOK, `{"errors": {"rateLimit": "Too many requests"}, "response": []}`},
+		{"daily limit in the envelope", http.StatusOK, `{"errors": {"requests": "You have reached the request limit for the day"}, "response": []}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			srv, _ := cassetteServer(t, tt.status, tt.body)
+
+			if _, err := cassetteClient(t, CassetteRecord, dir, srv.URL).FetchCountries(context.Background()); err == nil {
+				t.Fatal("FetchCountries() error = nil, want the failure")
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatalf("ReadDir() error = %v", err)
+			}
+			if len(entries) != 0 {
+				t.Errorf("recorded %d cassettes, want none", len(entries))
+			}
+		})
+	}
+}
\ No newline at end of file
@@ -0,0 +1,105 @@
+package football_client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("api-football rejected the api key")
+
+	ErrPlanLimit = errors.New("api-football plan does not cover this request")
+
+	ErrDailyLimitReached = errors.New("api-football daily request limit reached")
+
+	ErrRateLimited = errors.New("api-football per minute rate limit reached")
+
+	ErrInvalidParameters = errors.New("api-football rejected the request parameters")
+)
+
+
+type APIError struct {
+	Endpoint	string
+	Errors		map[string]string
+}
+
+
+func (e *APIError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for k := range e.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, 0, len(keys))
+	for _, k := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %s", k, e.Errors[k]))
+	}
+
+	return fmt.Sprintf("api-football returned errors for %s: %s", e.Endpoint, strings.Join(messages, "; "))
+}
+
+
+func (e *APIError) Is(target error) bool {
+	for k := range e.Errors {
+		if errorKind(k) == target {
+			return true
+		}
+	}
+	return false
+}
+
+
+func errorKind(key string) error {
+	switch strings.ToLower(key) {
+	case "token", "access":
+		return ErrInvalidAPIKey
+	case "plan":
+		return ErrPlanLimit
+	case "requests":
+		return ErrDailyLimitReached
+	case "ratelimit":
+		return ErrRateLimited
+	default:
+		return ErrInvalidParameters
+	}
+}
+
+
+// parseAPIErrors handles both shapes API-Football uses for the errors field:
+// an empty array when the call succeeded and an object keyed by cause otherwise.
+func parseAPIErrors(endpoint string, raw json.RawMessage) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	var byKey map[string]any
+	if err := json.Unmarshal(raw, &byKey); err == nil {
+		if len(byKey) == 0 {
+			return nil
+		}
+		apiErr := &APIError{Endpoint: endpoint, Errors: make(map[string]string, len(byKey))}
+		for k, v := range byKey {
+			apiErr.Errors[k] = fmt.Sprint(v)
+		}
+		return apiErr
+	}
+
+	var list []any
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return fmt.Errorf("failed to decode errors for %s: %w", endpoint, err)
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	apiErr := &APIError{Endpoint: endpoint, Errors: make(map[string]string, len(list))}
+	for i, v := range list {
+		apiErr.Errors[strconv.Itoa(i)] = fmt.Sprint(v)
+	}
+	return apiErr
+}
@@ -0,0 +1,87 @@
+package football_client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+
+func TestParseAPIErrors(t *testing.T) {
+	tests := []struct {
+		name	string
+		raw		string
+		want	error
+		message	string
+	}{
+		{name: "missing", raw: ""},
+		{name: "null", raw: "null"},
+		{name: "empty array", raw: "[]"},
+		{name: "empty object", raw: "{}"},
+		{name: "bad token", raw: `{"token": "Error/Missing application key"}`, want: ErrInvalidAPIKey},
+		{name: "access suspended", raw: `{"access": "Your account is suspended"}`, want: ErrInvalidAPIKey},
+		{name: "plan", raw: `{"plan": "Free plans do not have access to this season"}`, want: ErrPlanLimit},
+		{name: "daily limit", raw: `{"requests": "You have reached the request limit for the day"}`, want: ErrDailyLimitReached},
+		{name: "rate limit", raw: `{"rateLimit": "Too many requests"}`, want: ErrRateLimited},
+		{name: "parameter", raw: `{"season": "The Season field must be a 4-digit YYYY number"}`, want: ErrInvalidParameters},
+		{
+			name: 		"object keys sorted in the message",
+			raw: 		`{"team": "unknown", "season": "required"}`,
+			want: 		ErrInvalidParameters,
+			message: 	"api-football returned errors for /fixtures: season: required; team: unknown",
+		},
+		{
+			name: 		"array",
+			raw: 		`["The Season field is required.", "The League field is required."]`,
+			want: 		ErrInvalidParameters,
+			message: 	"api-football returned errors for /fixtures: 0: The Season field is required.; 1: The League field is required.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseAPIErrors("/fixtures", json.RawMessage(tt.raw))
+
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("parseAPIErrors(%s) error = %v, want nil", tt.raw, err)
+				}
+				return
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || !errors.Is(err, tt.want) {
+				t.Fatalf("parseAPIErrors(%s) error = %v, want an APIError matching %v", tt.raw, err, tt.want)
+			}
+			if tt.message != "" && err.Error() != tt.message {
+				t.Errorf("parseAPIErrors(%s) message = %q, want %q", tt.raw, err.Error(), tt.message)
+			}
+		})
+	}
+}
+
+
+func TestParseAPIErrorsRejectsOtherShapes(t *testing.T) {
+	err := parseAPIErrors("/fixtures", json.RawMessage(`"oops"`))
+
+	var apiErr *APIError
+	if err == nil || errors.As(err, &apiErr) {
+		t.Errorf("parseAPIErrors(\"oops\") error = %v, want a decode error", err)
+	}
+}
+
+
+func TestAPIErrorIs(t *testing.T) {
+	err := &APIError{Endpoint: "/fixtures", Errors: map[string]string{"rateLimit": "slow down", "season": "required"}}
+
+	for _, target := range []error{ErrRateLimited, ErrInvalidParameters} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) = false, want true", err, target)
+		}
+	}
+	for _, target := range []error{ErrInvalidAPIKey, ErrPlanLimit, ErrDailyLimitReached} {
+		if errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) = true, want false", err, target)
+		}
+	}
+}
\ No newline at end of file
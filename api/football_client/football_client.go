@@ -1,9 +1,12 @@
 package football_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"sync"
 	"time"
@@ -14,15 +17,30 @@ import (
 
 
 type FootballClient interface {
-	FetchCountries() (*model.CountryResponse, error)
-	FetchAllLeaguesForTeam() (*model.LeagueResponse, error)
-	FetchTeam() (*model.TeamResponse, error)
-	FetchTeamStats(teamID, leagueID int) ([]*model.TeamStatsResponse, error)
-	FetchVenues() (*model.VenueResponse, error)
-	FetchStandings(leagueID, teamID int) ([]*model.StandingResponse, error)
-	FetchFixtures(leagueID, teamID int) ([]*model.FixtureResponse, error)
-	FetchInjuries(teamID int) ([]*model.InjuryResponse, error)
-	FetchSquad(teamID int) (*model.SquadResponse, error)
+	FetchCountries(ctx context.Context) (*model.CountryResponse, error)
+	FetchAllLeaguesForTeam(ctx context.Context, teamID int) (*model.LeagueResponse, error)
+	FetchTeam(ctx context.Context, teamID int) (*model.TeamResponse, error)
+	FetchTeamStats(ctx context.Context, teamID, leagueID int, seasons []int) ([]*model.TeamStatsResponse, error)
+	FetchVenues(ctx context.Context, country string) (*model.VenueResponse, error)
+	FetchStandings(ctx context.Context, leagueID int, seasons []int) ([]*model.StandingResponse, error)
+	FetchFixtures(ctx context.Context, leagueID, teamID int, seasons []int) ([]*model.FixtureResponse, error)
+	FetchLeagueFixtures(ctx context.Context, leagueID int, seasons []int) ([]*model.FixtureResponse, error)
+	FetchInjuries(ctx context.Context, teamID int, seasons []int) ([]*model.InjuryResponse, error)
+	FetchSquad(ctx context.Context, teamID int) (*model.SquadResponse, error)
+	FetchFixtureEvents(ctx context.Context, fixtureIDs []int) ([]*model.FixtureEventResponse, error)
+	FetchFixtureLineups(ctx context.Context, fixtureIDs []int) ([]*model.FixtureLineupResponse, error)
+	FetchFixtureStatistics(ctx context.Context, fixtureIDs []int) ([]*model.FixtureStatisticsResponse, error)
+	FetchPlayerStats(ctx context.Context, teamID int, seasons []int) ([]*model.PlayerStatsResponse, error)
+	FetchTopScorers(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error)
+	FetchTopAssists(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error)
+	FetchTopYellowCards(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error)
+	FetchTopRedCards(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error)
+	FetchTransfers(ctx context.Context, teamID int) (*model.TransferResponse, error)
+	FetchCoaches(ctx context.Context, teamID int) (*model.CoachResponse, error)
+	FetchPlayerTrophies(ctx context.Context, playerIDs []int) ([]*model.TrophyResponse, error)
+	FetchCoachTrophies(ctx context.Context, coachIDs []int) ([]*model.TrophyResponse, error)
+	FetchSidelined(ctx context.Context, playerIDs []int) ([]*model.SidelinedResponse, error)
+	FetchHeadToHead(ctx context.Context, teamID, opponentID int) (*model.FixtureResponse, error)
 }
 
 
@@ -30,27 +48,115 @@ type footballClient struct {
 	httpClient 	*http.Client
 	apiKey		string
 	baseUrl		string
+	retry		retryPolicy
+	limiter		*rateLimiter
+	quota		*quotaTracker
 }
 
 
-func NewFootballClient(db *gorm.DB) FootballClient {
+type Option func(*clientOptions)
+
+
+type clientOptions struct {
+	cassetteMode	CassetteMode
+	cassetteDir		string
+}
+
+
+func WithCassette(mode CassetteMode, dir string) Option {
+	return func(o *clientOptions) {
+		if mode != "" {
+			o.cassetteMode = mode
+		}
+		if dir != "" {
+			o.cassetteDir = dir
+		}
+	}
+}
+
+
+func NewFootballClient(db *gorm.DB, opts ...Option) (FootballClient, error) {
 	apiKey 	:= os.Getenv("API_KEY")
 	baseUrl := os.Getenv("BASE_URL")
 
-	return &footballClient{
+	cassetteMode, err := ParseCassetteMode(os.Getenv("FOOTBALL_CASSETTE_MODE"))
+	if err != nil {
+		return nil, err
+	}
+
+	options := clientOptions{cassetteMode: cassetteMode, cassetteDir: defaultCassetteDir}
+	if dir := os.Getenv("FOOTBALL_CASSETTE_DIR"); dir != "" {
+		options.cassetteDir = dir
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	client := &footballClient{
 		httpClient: 	&http.Client{
 			Timeout: 	15 * time.Second,
 		},
 		apiKey: 	apiKey,
 		baseUrl: 	baseUrl,
+		retry: 		retryPolicyFromEnv(),
+		limiter: 	newRateLimiter(requestsPerMinuteFromEnv()),
+		quota: 		newQuotaTracker(db),
+	}
+
+	switch options.cassetteMode {
+	case CassetteRecord:
+		client.httpClient.Transport = newCassetteTransport(CassetteRecord, options.cassetteDir, client.baseUrl, http.DefaultTransport)
+	case CassetteReplay:
+		if client.baseUrl == "" {
+			client.baseUrl = replayBaseUrl
+		}
+		client.httpClient.Transport = newCassetteTransport(CassetteReplay, options.cassetteDir, client.baseUrl, nil)
+		client.limiter = nil
+		client.quota = nil
 	}
+
+	return client, nil
 }
 
 
-func (f *footballClient) get(endpoint string, data any) error {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", f.baseUrl, endpoint), nil)
+func (f *footballClient) get(ctx context.Context, endpoint string, data any) error {
+	var err error
+	for attempt := 0; attempt < f.retry.maxAttempts; attempt++ {
+		if attempt > 0 {
+			if waitErr := sleep(ctx, f.retry.wait(attempt - 1, err)); waitErr != nil {
+				return waitErr
+			}
+		}
+
+		if err = f.quota.check(); err != nil {
+			return err
+		}
+		if err = f.limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		var body []byte
+		body, err = f.do(ctx, endpoint)
+		if err == nil {
+			err = decode(endpoint, body, data)
+		}
+		if err == nil {
+			return nil
+		}
+
+		if !isRetryable(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", f.retry.maxAttempts, err)
+}
+
+
+func (f *footballClient) do(ctx context.Context, endpoint string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", f.baseUrl, endpoint), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req.Header.Add("x-rapidapi-key", f.apiKey)
@@ -58,67 +164,80 @@ func (f *footballClient) get(endpoint string, data any) error {
 
 	res, err := f.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer res.Body.Close()
 
+	f.quota.record(res.Header)
+
 	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed request to %s: %s", endpoint, res.Status)
+		return nil, &StatusError{
+			Endpoint: 		endpoint,
+			StatusCode: 	res.StatusCode,
+			Status: 		res.Status,
+			RetryAfter: 	parseRetryAfter(res.Header.Get("Retry-After")),
+		}
 	}
 
-	return json.NewDecoder(res.Body).Decode(&data)
+	return io.ReadAll(res.Body)
 }
 
 
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
 
-func (f *footballClient) FetchCountries() (*model.CountryResponse, error) {
-	var data model.CountryResponse
-	if err := f.get("/countries", &data); err != nil {
-		return nil, err
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return &data, nil
 }
 
 
-func (f *footballClient) FetchAllLeaguesForTeam() (*model.LeagueResponse, error) {
-	var data model.LeagueResponse
-	if err := f.get("/leagues?team=33", &data); err != nil {
-		return nil, err
+func decode(endpoint string, body []byte, data any) error {
+	var envelope model.APIEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", endpoint, err)
 	}
-	return &data, nil
+
+	if err := parseAPIErrors(endpoint, envelope.Errors); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, data)
 }
 
 
-func (f *footballClient) FetchTeam() (*model.TeamResponse, error) {
-	var data model.TeamResponse
-	if err := f.get("/teams?id=33", &data); err != nil {
-		return nil, err
-	}
-	return &data, nil
+func fetchSeasons[T any](ctx context.Context, seasons []int, fetch func(ctx context.Context, season int) (*T, error)) ([]*T, error) {
+	return fetchEach(ctx, "season", seasons, fetch)
 }
 
 
-func (f *footballClient) FetchTeamStats(teamID, leagueID int) ([]*model.TeamStatsResponse, error) {
-	seasons := []int{2021, 2022, 2023}
-	results := make([]*model.TeamStatsResponse, len(seasons))
+func fetchEach[T any](ctx context.Context, label string, ids []int, fetch func(ctx context.Context, id int) (*T, error)) ([]*T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*T, len(ids))
 
 	var wg sync.WaitGroup
-	errCh := make(chan error, len(seasons))
+	errCh := make(chan error, len(ids))
 
-	for i, season := range seasons {
+	for i, id := range ids {
 		wg.Add(1)
 
-		go func(i, season int) {
+		go func(i, id int) {
 			defer wg.Done()
 
-			var data model.TeamStatsResponse
-			endpoint := fmt.Sprintf("/teams/statistics?league=%d&team=%d&season=%d", leagueID, teamID, season)
-			if err := f.get(endpoint, &data); err != nil {
-				errCh <- fmt.Errorf("season %d: %w", season, err)
-				return 
+			data, err := fetch(ctx, id)
+			if err != nil {
+				errCh <- fmt.Errorf("%s %d: %w", label, id, err)
+				cancel()
+				return
 			}
-			results[i] = &data
-		}(i, season)
+			results[i] = data
+		}(i, id)
 	}
 
 	wg.Wait()
@@ -132,121 +251,270 @@ func (f *footballClient) FetchTeamStats(teamID, leagueID int) ([]*model.TeamStat
 }
 
 
-func (f *footballClient) FetchVenues() (*model.VenueResponse, error) {
-	var data model.VenueResponse
-	if err := f.get("/venues?country=england", &data); err != nil {
+
+func (f *footballClient) FetchCountries(ctx context.Context) (*model.CountryResponse, error) {
+	var data model.CountryResponse
+	if err := f.get(ctx, "/countries", &data); err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
 
-func (f *footballClient) FetchStandings(leagueID, teamID int) ([]*model.StandingResponse, error) {
-	seasons := []int{2021, 2022, 2023}
-	results := make([]*model.StandingResponse, len(seasons))
+func (f *footballClient) FetchAllLeaguesForTeam(ctx context.Context, teamID int) (*model.LeagueResponse, error) {
+	var data model.LeagueResponse
+	endpoint := fmt.Sprintf("/leagues?team=%d", teamID)
+	if err := f.get(ctx, endpoint, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
 
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(seasons))
 
-	for i, season := range seasons {
-		wg.Add(1)
+func (f *footballClient) FetchTeam(ctx context.Context, teamID int) (*model.TeamResponse, error) {
+	var data model.TeamResponse
+	endpoint := fmt.Sprintf("/teams?id=%d", teamID)
+	if err := f.get(ctx, endpoint, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
 
-		go func(i, season int) {
-			defer wg.Done()
 
-			var data model.StandingResponse
-			endpoint := fmt.Sprintf("/standings?league=%d&season=%d&team=%d", leagueID, season, teamID)
-			if err := f.get(endpoint, &data); err != nil {
-				errCh <- fmt.Errorf("season %d: %w", season, err)
-				return 
-			}
-			results[i] = &data
-		}(i, season)
-	}
+func (f *footballClient) FetchTeamStats(ctx context.Context, teamID, leagueID int, seasons []int) ([]*model.TeamStatsResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.TeamStatsResponse, error) {
+		var data model.TeamStatsResponse
+		endpoint := fmt.Sprintf("/teams/statistics?league=%d&team=%d&season=%d", leagueID, teamID, season)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
 
-	wg.Wait()
-	close(errCh)
 
-	if len(errCh) > 0 {
-		return nil, <- errCh
+func (f *footballClient) FetchVenues(ctx context.Context, country string) (*model.VenueResponse, error) {
+	var data model.VenueResponse
+	endpoint := fmt.Sprintf("/venues?country=%s", url.QueryEscape(country))
+	if err := f.get(ctx, endpoint, &data); err != nil {
+		return nil, err
 	}
+	return &data, nil
+}
 
-	return results, nil
+
+func (f *footballClient) FetchStandings(ctx context.Context, leagueID int, seasons []int) ([]*model.StandingResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.StandingResponse, error) {
+		var data model.StandingResponse
+		endpoint := fmt.Sprintf("/standings?league=%d&season=%d", leagueID, season)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
 }
 
 
-func (f *footballClient) FetchFixtures(leagueID, teamID int) ([]*model.FixtureResponse, error) {
-	seasons := []int{2021, 2022, 2023}
-	results := make([]*model.FixtureResponse, len(seasons))
+func (f *footballClient) FetchFixtures(ctx context.Context, leagueID, teamID int, seasons []int) ([]*model.FixtureResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.FixtureResponse, error) {
+		var data model.FixtureResponse
+		endpoint := fmt.Sprintf("/fixtures?league=%d&season=%d&team=%d", leagueID, season, teamID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
 
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(seasons))
 
-	for i, season := range seasons {
-		wg.Add(1)
+func (f *footballClient) FetchLeagueFixtures(ctx context.Context, leagueID int, seasons []int) ([]*model.FixtureResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.FixtureResponse, error) {
+		var data model.FixtureResponse
+		endpoint := fmt.Sprintf("/fixtures?league=%d&season=%d", leagueID, season)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
 
-		go func(i, season int) {
-			defer wg.Done()
 
-			var data model.FixtureResponse
-			endpoint := fmt.Sprintf("/fixtures?league=%d&season=%d&team=%d", leagueID, season, teamID)
-			if err := f.get(endpoint, &data); err != nil {
-				errCh <- fmt.Errorf("season %d: %w", season, err)
-				return 
-			}
-			results[i] = &data
-		}(i, season)
-	}
+func (f *footballClient) FetchInjuries(ctx context.Context, teamID int, seasons []int) ([]*model.InjuryResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.InjuryResponse, error) {
+		var data model.InjuryResponse
+		endpoint := fmt.Sprintf("/injuries?season=%d&team=%d", season, teamID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
 
-	wg.Wait()
-	close(errCh)
 
-	if len(errCh) > 0 {
-		return nil, <- errCh
+func (f *footballClient) FetchSquad(ctx context.Context, teamID int) (*model.SquadResponse, error) {
+	var data model.SquadResponse
+	endpoint := fmt.Sprintf("/players/squads?team=%d", teamID)
+	if err := f.get(ctx, endpoint, &data); err != nil {
+		return nil, err
 	}
 
-	return results, nil
+	return &data, nil
 }
 
 
-func (f *footballClient) FetchInjuries(teamID int) ([]*model.InjuryResponse, error) {
-	seasons := []int{2021, 2022, 2023}
-	results := make([]*model.InjuryResponse, len(seasons))
+func (f *footballClient) FetchFixtureEvents(ctx context.Context, fixtureIDs []int) ([]*model.FixtureEventResponse, error) {
+	return fetchEach(ctx, "fixture", fixtureIDs, func(ctx context.Context, fixtureID int) (*model.FixtureEventResponse, error) {
+		var data model.FixtureEventResponse
+		endpoint := fmt.Sprintf("/fixtures/events?fixture=%d", fixtureID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
 
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(seasons))
 
-	for i, season := range seasons {
-		wg.Add(1)
+func (f *footballClient) FetchFixtureLineups(ctx context.Context, fixtureIDs []int) ([]*model.FixtureLineupResponse, error) {
+	return fetchEach(ctx, "fixture", fixtureIDs, func(ctx context.Context, fixtureID int) (*model.FixtureLineupResponse, error) {
+		var data model.FixtureLineupResponse
+		endpoint := fmt.Sprintf("/fixtures/lineups?fixture=%d", fixtureID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
 
-		go func(i, season int) {
-			defer wg.Done()
 
-			var data model.InjuryResponse
-			endpoint := fmt.Sprintf("/injuries?season=%d&team=%d", season, teamID)
-			if err := f.get(endpoint, &data); err != nil {
-				errCh <- fmt.Errorf("season %d: %w", season, err)
-				return 
+func (f *footballClient) FetchFixtureStatistics(ctx context.Context, fixtureIDs []int) ([]*model.FixtureStatisticsResponse, error) {
+	return fetchEach(ctx, "fixture", fixtureIDs, func(ctx context.Context, fixtureID int) (*model.FixtureStatisticsResponse, error) {
+		var data model.FixtureStatisticsResponse
+		endpoint := fmt.Sprintf("/fixtures/statistics?fixture=%d", fixtureID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
+
+
+// FetchPlayerStats walks every page of /players for each season and merges them
+// into a single response per season.
+func (f *footballClient) FetchPlayerStats(ctx context.Context, teamID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.PlayerStatsResponse, error) {
+		var all model.PlayerStatsResponse
+		for page := 1; ; page++ {
+			var data model.PlayerStatsResponse
+			endpoint := fmt.Sprintf("/players?team=%d&season=%d&page=%d", teamID, season, page)
+			if err := f.get(ctx, endpoint, &data); err != nil {
+				return nil, fmt.Errorf("page %d: %w", page, err)
 			}
-			results[i] = &data
-		}(i, season)
+
+			all.Response = append(all.Response, data.Response...)
+			all.Paging = data.Paging
+
+			if page >= data.Paging.Total {
+				return &all, nil
+			}
+		}
+	})
+}
+
+
+func (f *footballClient) FetchTopScorers(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	return f.fetchTopPlayers(ctx, "topscorers", leagueID, seasons)
+}
+
+
+func (f *footballClient) FetchTopAssists(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	return f.fetchTopPlayers(ctx, "topassists", leagueID, seasons)
+}
+
+
+func (f *footballClient) FetchTopYellowCards(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	return f.fetchTopPlayers(ctx, "topyellowcards", leagueID, seasons)
+}
+
+
+func (f *footballClient) FetchTopRedCards(ctx context.Context, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	return f.fetchTopPlayers(ctx, "topredcards", leagueID, seasons)
+}
+
+
+func (f *footballClient) fetchTopPlayers(ctx context.Context, leaderboard string, leagueID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	return fetchSeasons(ctx, seasons, func(ctx context.Context, season int) (*model.PlayerStatsResponse, error) {
+		var data model.PlayerStatsResponse
+		endpoint := fmt.Sprintf("/players/%s?league=%d&season=%d", leaderboard, leagueID, season)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
+
+
+func (f *footballClient) FetchTransfers(ctx context.Context, teamID int) (*model.TransferResponse, error) {
+	var data model.TransferResponse
+	endpoint := fmt.Sprintf("/transfers?team=%d", teamID)
+	if err := f.get(ctx, endpoint, &data); err != nil {
+		return nil, err
 	}
 
-	wg.Wait()
-	close(errCh)
+	return &data, nil
+}
 
-	if len(errCh) > 0 {
-		return nil, <- errCh
+
+func (f *footballClient) FetchCoaches(ctx context.Context, teamID int) (*model.CoachResponse, error) {
+	var data model.CoachResponse
+	endpoint := fmt.Sprintf("/coachs?team=%d", teamID)
+	if err := f.get(ctx, endpoint, &data); err != nil {
+		return nil, err
 	}
 
-	return results, nil
+	return &data, nil
 }
 
 
-func (f *footballClient) FetchSquad(teamID int) (*model.SquadResponse, error) {
-	var data model.SquadResponse
-	endpoint := fmt.Sprintf("/players/squads?team=%d", teamID)
-	if err := f.get(endpoint, &data); err != nil {
+func (f *footballClient) FetchPlayerTrophies(ctx context.Context, playerIDs []int) ([]*model.TrophyResponse, error) {
+	return fetchEach(ctx, "player", playerIDs, func(ctx context.Context, playerID int) (*model.TrophyResponse, error) {
+		var data model.TrophyResponse
+		endpoint := fmt.Sprintf("/trophies?player=%d", playerID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
+
+
+func (f *footballClient) FetchCoachTrophies(ctx context.Context, coachIDs []int) ([]*model.TrophyResponse, error) {
+	return fetchEach(ctx, "coach", coachIDs, func(ctx context.Context, coachID int) (*model.TrophyResponse, error) {
+		var data model.TrophyResponse
+		endpoint := fmt.Sprintf("/trophies?coach=%d", coachID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
+
+
+func (f *footballClient) FetchSidelined(ctx context.Context, playerIDs []int) ([]*model.SidelinedResponse, error) {
+	return fetchEach(ctx, "player", playerIDs, func(ctx context.Context, playerID int) (*model.SidelinedResponse, error) {
+		var data model.SidelinedResponse
+		endpoint := fmt.Sprintf("/sidelined?player=%d", playerID)
+		if err := f.get(ctx, endpoint, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	})
+}
+
+
+func (f *footballClient) FetchHeadToHead(ctx context.Context, teamID, opponentID int) (*model.FixtureResponse, error) {
+	var data model.FixtureResponse
+	endpoint := fmt.Sprintf("/fixtures/headtohead?h2h=%d-%d", teamID, opponentID)
+	if err := f.get(ctx, endpoint, &data); err != nil {
 		return nil, err
 	}
 
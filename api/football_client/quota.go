@@ -0,0 +1,193 @@
+package football_client
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+	"gorm.io/gorm"
+)
+
+var ErrDailyQuotaExhausted = errors.New("api-football daily request quota exhausted")
+
+
+const (
+	quotaRecordID = 1
+
+	defaultRequestsPerMinute = 10
+)
+
+
+type rateLimiter struct {
+	mu			sync.Mutex
+	tokens		float64
+	capacity	float64
+	perSecond	float64
+	last		time.Time
+}
+
+
+func requestsPerMinuteFromEnv() int {
+	if v, err := strconv.Atoi(os.Getenv("API_RATE_LIMIT_PER_MINUTE")); err == nil && v > 0 {
+		return v
+	}
+	return defaultRequestsPerMinute
+}
+
+
+func newRateLimiter(perMinute int) *rateLimiter {
+	return &rateLimiter{
+		tokens: 	float64(perMinute),
+		capacity: 	float64(perMinute),
+		perSecond: 	float64(perMinute) / 60,
+		last: 		time.Now(),
+	}
+}
+
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.capacity, l.tokens + now.Sub(l.last).Seconds() * l.perSecond)
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - l.tokens) / l.perSecond * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+
+type quotaTracker struct {
+	mu		sync.Mutex
+	db		*gorm.DB
+	quota	model.APIQuota
+	reserve	int
+}
+
+
+func newQuotaTracker(db *gorm.DB) *quotaTracker {
+	tracker := &quotaTracker{db: db, quota: model.APIQuota{ID: quotaRecordID}}
+
+	if v, err := strconv.Atoi(os.Getenv("API_DAILY_QUOTA_RESERVE")); err == nil && v > 0 {
+		tracker.reserve = v
+	}
+
+	if db != nil {
+		db.Limit(1).Find(&tracker.quota, quotaRecordID)
+	}
+
+	return tracker
+}
+
+
+// check refuses new requests once the remaining daily quota recorded today
+// (API-Football resets it at midnight UTC) drops to the configured reserve.
+func (q *quotaTracker) check() error {
+	if q == nil {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.quota.DailyUpdatedAt.IsZero() || q.quota.DailyLimit == 0 {
+		return nil
+	}
+
+	if !sameUTCDay(q.quota.DailyUpdatedAt, time.Now()) {
+		return nil
+	}
+
+	if q.quota.DailyRemaining <= q.reserve {
+		return ErrDailyQuotaExhausted
+	}
+
+	return nil
+}
+
+
+// record keeps the quota fields whose headers the response carried. The daily
+// and per-minute pairs are independent, replayed cassettes and the mock
+// provider may send only one of them, or none.
+func (q *quotaTracker) record(header http.Header) {
+	if q == nil {
+		return
+	}
+
+	dailyLimit, dailyOk := headerInt(header, "x-ratelimit-requests-limit")
+	dailyRemaining, remainingOk := headerInt(header, "x-ratelimit-requests-remaining")
+	minuteLimit, minuteOk := headerInt(header, "X-RateLimit-Limit")
+	minuteRemaining, minuteRemainingOk := headerInt(header, "X-RateLimit-Remaining")
+
+	if !dailyOk && !remainingOk && !minuteOk && !minuteRemainingOk {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	if dailyOk {
+		q.quota.DailyLimit = dailyLimit
+	}
+	if remainingOk {
+		q.quota.DailyRemaining = dailyRemaining
+	}
+	if dailyOk || remainingOk {
+		q.quota.DailyUpdatedAt = now
+	}
+	if minuteOk {
+		q.quota.MinuteLimit = minuteLimit
+	}
+	if minuteRemainingOk {
+		q.quota.MinuteRemaining = minuteRemaining
+	}
+	q.quota.UpdatedAt = now
+
+	if q.db == nil {
+		return
+	}
+
+	if err := q.db.Save(&q.quota).Error; err != nil {
+		log.Printf("failed to persist api-football quota: %v", err)
+	}
+}
+
+
+func headerInt(header http.Header, key string) (int, bool) {
+	v, err := strconv.Atoi(header.Get(key))
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+
+func sameUTCDay(a, b time.Time) bool {
+	ay, am, ad := a.UTC().Date()
+	by, bm, bd := b.UTC().Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,239 @@
+package football_client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+)
+
+
+func quotaHeader(pairs ...string) http.Header {
+	header := make(http.Header)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		header.Set(pairs[i], pairs[i+1])
+	}
+	return header
+}
+
+
+func TestQuotaTrackerRecord(t *testing.T) {
+	stored := model.APIQuota{ID: quotaRecordID, DailyLimit: 100, DailyRemaining: 50, MinuteLimit: 10, MinuteRemaining: 5}
+
+	tests := []struct {
+		name			string
+		header			http.Header
+		want			model.APIQuota
+		dailyUpdated	bool
+		updated			bool
+	}{
+		{
+			name: 			"every header",
+			header: 		quotaHeader("x-ratelimit-requests-limit", "7500", "x-ratelimit-requests-remaining", "7400", "X-RateLimit-Limit", "300", "X-RateLimit-Remaining", "299"),
+			want: 			model.APIQuota{DailyLimit: 7500, DailyRemaining: 7400, MinuteLimit: 300, MinuteRemaining: 299},
+			dailyUpdated: 	true,
+			updated: 		true,
+		},
+		{
+			name: 			"per-minute headers only",
+			header: 		quotaHeader("X-RateLimit-Limit", "300", "X-RateLimit-Remaining", "299"),
+			want: 			model.APIQuota{DailyLimit: 100, DailyRemaining: 50, MinuteLimit: 300, MinuteRemaining: 299},
+			updated: 		true,
+		},
+		{
+			name: 			"daily remaining only",
+			header: 		quotaHeader("x-ratelimit-requests-remaining", "49"),
+			want: 			model.APIQuota{DailyLimit: 100, DailyRemaining: 49, MinuteLimit: 10, MinuteRemaining: 5},
+			dailyUpdated: 	true,
+			updated: 		true,
+		},
+		{
+			name: 			"malformed headers are ignored",
+			header: 		quotaHeader("x-ratelimit-requests-limit", "many", "X-RateLimit-Remaining", ""),
+			want: 			model.APIQuota{DailyLimit: 100, DailyRemaining: 50, MinuteLimit: 10, MinuteRemaining: 5},
+		},
+		{
+			name: 			"no headers",
+			header: 		http.Header{},
+			want: 			model.APIQuota{DailyLimit: 100, DailyRemaining: 50, MinuteLimit: 10, MinuteRemaining: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &quotaTracker{quota: stored}
+			q.record(tt.header)
+
+			got := q.quota
+			if got.DailyLimit != tt.want.DailyLimit || got.DailyRemaining != tt.want.DailyRemaining || got.MinuteLimit != tt.want.MinuteLimit || got.MinuteRemaining != tt.want.MinuteRemaining {
+				t.Errorf("record() quota = %d/%d daily, %d/%d per minute, want %d/%d daily, %d/%d per minute", got.DailyRemaining, got.DailyLimit, got.MinuteRemaining, got.MinuteLimit, tt.want.DailyRemaining, tt.want.DailyLimit, tt.want.MinuteRemaining, tt.want.MinuteLimit)
+			}
+			if !got.DailyUpdatedAt.IsZero() != tt.dailyUpdated {
+				t.Errorf("record() daily updated = %v, want %v", !got.DailyUpdatedAt.IsZero(), tt.dailyUpdated)
+			}
+			if !got.UpdatedAt.IsZero() != tt.updated {
+				t.Errorf("record() updated = %v, want %v", !got.UpdatedAt.IsZero(), tt.updated)
+			}
+		})
+	}
+}
+
+
+func TestQuotaTrackerCheck(t *testing.T) {
+	now := time.Now().UTC()
+	yesterday := now.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name		string
+		quota		model.APIQuota
+		reserve		int
+		want		error
+	}{
+		{"never recorded", model.APIQuota{}, 0, nil},
+		{"requests left", model.APIQuota{DailyLimit: 100, DailyRemaining: 1, DailyUpdatedAt: now}, 0, nil},
+		{"exhausted today", model.APIQuota{DailyLimit: 100, DailyRemaining: 0, DailyUpdatedAt: now}, 0, ErrDailyQuotaExhausted},
+		{"at the reserve", model.APIQuota{DailyLimit: 100, DailyRemaining: 10, DailyUpdatedAt: now}, 10, ErrDailyQuotaExhausted},
+		{"above the reserve", model.APIQuota{DailyLimit: 100, DailyRemaining: 11, DailyUpdatedAt: now}, 10, nil},
+		{"exhausted before the UTC day rolled over", model.APIQuota{DailyLimit: 100, DailyRemaining: 0, DailyUpdatedAt: yesterday}, 0, nil},
+		{"per-minute update today does not revive yesterday's count", model.APIQuota{DailyLimit: 100, DailyRemaining: 0, DailyUpdatedAt: yesterday, UpdatedAt: now}, 0, nil},
+		{"no daily limit recorded", model.APIQuota{DailyRemaining: 0, DailyUpdatedAt: now}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &quotaTracker{quota: tt.quota, reserve: tt.reserve}
+			if err := q.check(); err != tt.want {
+				t.Errorf("check() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestSameUTCDay(t *testing.T) {
+	cet := time.FixedZone("CET", 60 * 60)
+
+	tests := []struct {
+		name	string
+		a, b	time.Time
+		want	bool
+	}{
+		{"same instant", time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), true},
+		{"either side of midnight UTC", time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC), time.Date(2024, 3, 2, 0, 1, 0, 0, time.UTC), false},
+		{"local date differs, UTC date does not", time.Date(2024, 3, 2, 0, 30, 0, 0, cet), time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameUTCDay(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameUTCDay(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestRequestsPerMinuteFromEnv(t *testing.T) {
+	tests := []struct {
+		value	string
+		want	int
+	}{
+		{"", defaultRequestsPerMinute},
+		{"300", 300},
+		{"0", defaultRequestsPerMinute},
+		{"-5", defaultRequestsPerMinute},
+		{"fast", defaultRequestsPerMinute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("API_RATE_LIMIT_PER_MINUTE", tt.value)
+			if got := requestsPerMinuteFromEnv(); got != tt.want {
+				t.Errorf("requestsPerMinuteFromEnv() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := newRateLimiter(5)
+
+	start := time.Now()
+	for i := range 5 {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatalf("wait() %d error = %v", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50 * time.Millisecond {
+		t.Errorf("a full bucket took %v to drain, want no wait", elapsed)
+	}
+}
+
+
+func TestRateLimiterBlocksUntilATokenRefills(t *testing.T) {
+	// 600 a minute refills a token every 100ms
+	l := newRateLimiter(600)
+	l.tokens = 0
+
+	start := time.Now()
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 80 * time.Millisecond || elapsed > time.Second {
+		t.Errorf("wait() returned after %v, want about 100ms", elapsed)
+	}
+}
+
+
+func TestRateLimiterHonoursCancellation(t *testing.T) {
+	l := newRateLimiter(1)
+	l.tokens = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20 * time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("wait() returned after %v, want it to stop at the deadline", elapsed)
+	}
+}
+
+
+func TestNilRateLimiterDoesNotWait(t *testing.T) {
+	var l *rateLimiter
+	if err := l.wait(context.Background()); err != nil {
+		t.Errorf("wait() error = %v, want nil", err)
+	}
+}
+
+func TestNewFootballClientRateLimit(t *testing.T) {
+	tests := []struct {
+		value	string
+		want	float64
+	}{
+		{"", defaultRequestsPerMinute},
+		{"300", 300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("API_RATE_LIMIT_PER_MINUTE", tt.value)
+			t.Setenv("FOOTBALL_CASSETTE_MODE", "")
+
+			client, err := NewFootballClient(nil)
+			if err != nil {
+				t.Fatalf("NewFootballClient() error = %v", err)
+			}
+			if got := client.(*footballClient).limiter.capacity; got != tt.want {
+				t.Errorf("limiter capacity = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
\ No newline at end of file
@@ -0,0 +1,123 @@
+package football_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+
+type retryPolicy struct {
+	maxAttempts	int
+	baseDelay	time.Duration
+	maxDelay	time.Duration
+}
+
+
+type StatusError struct {
+	Endpoint	string
+	StatusCode	int
+	Status		string
+	RetryAfter	time.Duration
+}
+
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("failed request to %s: %s", e.Endpoint, e.Status)
+}
+
+
+func retryPolicyFromEnv() retryPolicy {
+	policy := retryPolicy{
+		maxAttempts: 	4,
+		baseDelay: 		500 * time.Millisecond,
+		maxDelay: 		30 * time.Second,
+	}
+
+	if v, err := strconv.Atoi(os.Getenv("API_RETRY_ATTEMPTS")); err == nil && v > 0 {
+		policy.maxAttempts = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("API_RETRY_BASE_DELAY")); err == nil && v > 0 {
+		policy.baseDelay = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("API_RETRY_MAX_DELAY")); err == nil && v > 0 {
+		policy.maxDelay = v
+	}
+
+	return policy
+}
+
+
+// backoff returns an exponential delay for the given attempt with full jitter,
+// so concurrent season fetches don't retry in lockstep.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	delay := p.baseDelay << attempt
+	if delay <= 0 || delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+
+	return time.Duration(rand.Int64N(int64(delay) + 1))
+}
+
+
+// wait honours Retry-After but never beyond maxDelay, a server asking for an
+// hour would otherwise hold a fetch goroutine that long.
+func (p retryPolicy) wait(attempt int, err error) time.Duration {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		return min(statusErr.RetryAfter, p.maxDelay)
+	}
+	return p.backoff(attempt)
+}
+
+
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCassetteMiss) {
+		return false
+	}
+
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	// only transport failures that can pass on their own, TLS errors, bad
+	// schemes and malformed URLs fail the same way on every attempt
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,275 @@
+package football_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+
+type scriptedResponse struct {
+	status	int
+	header	map[string]string
+	body	string
+}
+
+
+// scriptedServer answers each request with the next response of the script and
+// repeats the last one once the script runs out.
+func scriptedServer(t *testing.T, script []scriptedResponse) (*httptest.Server, func() int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		res := script[min(requests, len(script) - 1)]
+		requests++
+		mu.Unlock()
+
+		for k, v := range res.header {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(res.status)
+		io.WriteString(w, res.body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+
+func testClient(srv *httptest.Server) *footballClient {
+	return &footballClient{
+		httpClient: 	srv.Client(),
+		baseUrl: 		srv.URL,
+		retry: 			retryPolicy{maxAttempts: 3, baseDelay: time.Millisecond, maxDelay: 20 * time.Millisecond},
+	}
+}
+
+
+const okBody = `{"errors": [], "response": ["ok"]}`
+
+
+func TestGetRetries(t *testing.T) {
+	tests := []struct {
+		name		string
+		script		[]scriptedResponse
+		requests	int
+		want		error
+	}{
+		{
+			name: 		"429 with Retry-After, then 200",
+			script: 	[]scriptedResponse{{status: 429, header: map[string]string{"Retry-After": "2"}}, {status: 200, body: okBody}},
+			requests: 	2,
+		},
+		{
+			name: 		"5xx, then 200",
+			script: 	[]scriptedResponse{{status: 503}, {status: 502}, {status: 200, body: okBody}},
+			requests: 	3,
+		},
+		{
+			name: 		"5xx on every attempt",
+			script: 	[]scriptedResponse{{status: 500}},
+			requests: 	3,
+			want: 		&StatusError{StatusCode: 500},
+		},
+		{
+			name: 		"4xx is not retried",
+			script: 	[]scriptedResponse{{status: 404}},
+			requests: 	1,
+			want: 		&StatusError{StatusCode: 404},
+		},
+		{
+			name: 		"rate limit in an errors object, then 200",
+			script: 	[]scriptedResponse{{status: 200, body: `{"errors": {"rateLimit": "Too many requests"}, "response": []}`}, {status: 200, body: okBody}},
+			requests: 	2,
+		},
+		{
+			name: 		"bad token in an errors object is not retried",
+			script: 	[]scriptedResponse{{status: 200, body: `{"errors": {"token": "Error/Missing application key"}, "response": []}`}},
+			requests: 	1,
+			want: 		ErrInvalidAPIKey,
+		},
+		{
+			name: 		"daily limit in an errors object is not retried",
+			script: 	[]scriptedResponse{{status: 200, body: `{"errors": {"requests": "You have reached the request limit for the day"}, "response": []}`}},
+			requests: 	1,
+			want: 		ErrDailyLimitReached,
+		},
+		{
+			name: 		"errors array is not retried",
+			script: 	[]scriptedResponse{{status: 200, body: `{"errors": ["The Season field is required."], "response": []}`}},
+			requests: 	1,
+			want: 		ErrInvalidParameters,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := scriptedServer(t, tt.script)
+
+			var data struct {
+				Response	[]string	`json:"response"`
+			}
+			start := time.Now()
+			err := testClient(srv).get(context.Background(), "/countries", &data)
+
+			if got := requests(); got != tt.requests {
+				t.Errorf("get() sent %d requests, want %d", got, tt.requests)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("get() took %v, Retry-After was not capped", elapsed)
+			}
+
+			var wantStatus *StatusError
+			switch {
+			case tt.want == nil:
+				if err != nil || len(data.Response) != 1 {
+					t.Errorf("get() = %v, %v, want the response", data.Response, err)
+				}
+			case errors.As(tt.want, &wantStatus):
+				var statusErr *StatusError
+				if !errors.As(err, &statusErr) || statusErr.StatusCode != wantStatus.StatusCode {
+					t.Errorf("get() error = %v, want status %d", err, wantStatus.StatusCode)
+				}
+			default:
+				if !errors.Is(err, tt.want) {
+					t.Errorf("get() error = %v, want %v", err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+
+func TestGetStopsWhenContextIsCancelled(t *testing.T) {
+	srv, requests := scriptedServer(t, []scriptedResponse{{status: 503}})
+
+	client := testClient(srv)
+	client.retry = retryPolicy{maxAttempts: 5, baseDelay: time.Hour, maxDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20 * time.Millisecond)
+	defer cancel()
+
+	var data struct{}
+	if err := client.get(ctx, "/countries", &data); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("get() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := requests(); got != 1 {
+		t.Errorf("get() sent %d requests, want 1", got)
+	}
+}
+
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string 		{ return "i/o timeout" }
+func (timeoutError) Timeout() bool 		{ return true }
+func (timeoutError) Temporary() bool 	{ return true }
+
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name	string
+		err		error
+		want	bool
+	}{
+		{"429", &StatusError{StatusCode: http.StatusTooManyRequests}, true},
+		{"500", &StatusError{StatusCode: http.StatusInternalServerError}, true},
+		{"503", &StatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"400", &StatusError{StatusCode: http.StatusBadRequest}, false},
+		{"403", &StatusError{StatusCode: http.StatusForbidden}, false},
+		{"rate limited in the envelope", &APIError{Errors: map[string]string{"rateLimit": "slow down"}}, true},
+		{"daily limit in the envelope", &APIError{Errors: map[string]string{"requests": "come back tomorrow"}}, false},
+		{"connection reset", &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, true},
+		{"connection refused", &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, true},
+		{"unexpected EOF", fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF), true},
+		{"timeout", &url.Error{Op: "Get", URL: "http://api", Err: timeoutError{}}, true},
+		{"unsupported scheme", &url.Error{Op: "Get", URL: "ftp://api", Err: errors.New("unsupported protocol scheme")}, false},
+		{"unknown host", &net.DNSError{Err: "no such host", Name: "api", IsNotFound: true}, false},
+		{"cancelled", fmt.Errorf("season 2023: %w", context.Canceled), false},
+		{"deadline", context.DeadlineExceeded, false},
+		{"cassette miss", ErrCassetteMiss, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name	string
+		value	string
+		min		time.Duration
+		max		time.Duration
+	}{
+		{"missing", "", 0, 0},
+		{"seconds", "5", 5 * time.Second, 5 * time.Second},
+		{"zero", "0", 0, 0},
+		{"negative", "-3", 0, 0},
+		{"garbage", "soon", 0, 0},
+		{"future date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), 59 * time.Minute, time.Hour},
+		{"past date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value); got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %v, want between %v and %v", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+
+func TestRetryPolicyWait(t *testing.T) {
+	policy := retryPolicy{maxAttempts: 4, baseDelay: 100 * time.Millisecond, maxDelay: 30 * time.Second}
+
+	tests := []struct {
+		name	string
+		attempt	int
+		err		error
+		max		time.Duration
+		exact	bool
+	}{
+		{"Retry-After below the cap", 0, &StatusError{StatusCode: 429, RetryAfter: 2 * time.Second}, 2 * time.Second, true},
+		{"Retry-After above the cap", 0, &StatusError{StatusCode: 429, RetryAfter: time.Hour}, 30 * time.Second, true},
+		{"first backoff", 0, &StatusError{StatusCode: 503}, 100 * time.Millisecond, false},
+		{"third backoff", 2, &StatusError{StatusCode: 503}, 400 * time.Millisecond, false},
+		{"backoff capped", 10, &StatusError{StatusCode: 503}, 30 * time.Second, false},
+		{"shift overflow capped", 70, io.ErrUnexpectedEOF, 30 * time.Second, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 50 {
+				got := policy.wait(tt.attempt, tt.err)
+				if got < 0 || got > tt.max || (tt.exact && got != tt.max) {
+					t.Fatalf("wait(%d, %v) = %v, want at most %v", tt.attempt, tt.err, got, tt.max)
+				}
+			}
+		})
+	}
+}
\ No newline at end of file
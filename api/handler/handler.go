@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 
 	"github.com/deikioveca/TheRedDevilsData/api/helper"
+	"github.com/deikioveca/TheRedDevilsData/api/model"
 	"github.com/deikioveca/TheRedDevilsData/api/service"
 )
 
@@ -19,9 +21,20 @@ func NewHandler(s service.Service) *Handler {
 
 
 func (h *Handler) GetCountries(w http.ResponseWriter, r *http.Request) {
-	data, err := h.service.GetCountries()
+	q, err := helper.QueryListParams(r)
 	if err != nil {
-		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetCountries(r.Context(), q)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidListFilter, service.ErrInvalidListSort:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
 		return
 	}
 
@@ -32,7 +45,7 @@ func (h *Handler) GetCountries(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetCountryByName(w http.ResponseWriter, r *http.Request) {
 	countryName := r.PathValue("name")
 
-	data, err := h.service.GetCountryByName(countryName)
+	data, err := h.service.GetCountryByName(r.Context(), countryName)
 	if err != nil {
 		switch err {
 		case service.ErrCountryNotFound:
@@ -48,9 +61,28 @@ func (h *Handler) GetCountryByName(w http.ResponseWriter, r *http.Request) {
 
 
 func (h *Handler) GetLeagues(w http.ResponseWriter, r *http.Request) {
-	data, err := h.service.GetLeagues()
+	teamID, err := helper.QueryTeamID(r)
 	if err != nil {
-		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	q, err := helper.QueryListParams(r, "team")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetLeagues(r.Context(), teamID, q)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidListFilter, service.ErrInvalidListSort:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
 		return
 	}
 
@@ -59,10 +91,16 @@ func (h *Handler) GetLeagues(w http.ResponseWriter, r *http.Request) {
 
 
 func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
-	data, err := h.service.GetTeam()
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetTeam(r.Context(), teamID)
 	if err != nil {
 		switch err {
-		case service.ErrManchesterUnitedNotFound:
+		case service.ErrTeamNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -82,10 +120,22 @@ func (h *Handler) GetTeamStatsGames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsGames(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	coachID, err := helper.QueryInt(r, "coach")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'coach'")
+		return
+	}
+
+	data, err := h.service.GetTeamStatsGames(r.Context(), teamID, season, coachID)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked, service.ErrCoachTenureNotFound:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -105,10 +155,90 @@ func (h *Handler) GetTeamStatsGoals(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsGoals(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	coachID, err := helper.QueryInt(r, "coach")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'coach'")
+		return
+	}
+
+	data, err := h.service.GetTeamStatsGoals(r.Context(), teamID, season, coachID)
+	if err != nil {
+		switch err {
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked, service.ErrCoachTenureNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTeamStatsGoalsMinutes(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsGoalsMinutes(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrTeamStatsNotFound, service.ErrDistributionNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTeamStatsGoalsUnderOver(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsGoalsUnderOver(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrDistributionNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -128,10 +258,21 @@ func (h *Handler) GetTeamStatsStreak(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsStreak(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsStreak(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -151,10 +292,21 @@ func (h *Handler) GetTeamStatsBiggest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsBiggest(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsBiggest(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -174,10 +326,21 @@ func (h *Handler) GetTeamStatsCleanSheet(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	data, err := h.service.GetTeamStatsCleanSheet(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsCleanSheet(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -197,10 +360,21 @@ func (h *Handler) GetTeamStatsFailedToScore(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	data, err := h.service.GetTeamStatsFailedToScore(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsFailedToScore(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -220,10 +394,21 @@ func (h *Handler) GetTeamStatsPenalty(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsPenalty(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsPenalty(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -243,10 +428,55 @@ func (h *Handler) GetTeamStatsCards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsCards(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsCards(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTeamStatsCardsMinutes(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsCardsMinutes(r.Context(), teamID, season)
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound:
+		case service.ErrTeamStatsNotFound, service.ErrDistributionNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -266,10 +496,56 @@ func (h *Handler) GetTeamStatsLineups(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetTeamStatsLineup(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsLineup(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrTeamStatsNotFound, service.ErrLineupNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTeamStatsComparison(w http.ResponseWriter, r *http.Request) {
+	seasons, err := helper.QueryInts(r, "seasons")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'seasons'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetTeamStatsComparison(r.Context(), teamID, seasons, helper.QueryList(r, "sections"))
 	if err != nil {
 		switch err {
-		case service.ErrTeamStatsNotFound, service.ErrLineupNotFound:
+		case service.ErrComparisonSeasons, service.ErrInvalidComparisonSection:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrTeamStatsNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -282,9 +558,20 @@ func (h *Handler) GetTeamStatsLineups(w http.ResponseWriter, r *http.Request) {
 
 
 func (h *Handler) GetVenues(w http.ResponseWriter, r *http.Request) {
-	data, err := h.service.GetVenues()
+	q, err := helper.QueryListParams(r)
 	if err != nil {
-		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetVenues(r.Context(), q)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidListFilter, service.ErrInvalidListSort:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
 		return
 	}
 
@@ -295,7 +582,7 @@ func (h *Handler) GetVenues(w http.ResponseWriter, r *http.Request) {
 func(h *Handler) GetVenuesByCity(w http.ResponseWriter, r *http.Request) {
 	city := r.PathValue("city")
 
-	data, err := h.service.GetVenuesByCity(city)
+	data, err := h.service.GetVenuesByCity(r.Context(), city)
 	if err != nil {
 		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
 		return
@@ -306,7 +593,7 @@ func(h *Handler) GetVenuesByCity(w http.ResponseWriter, r *http.Request) {
 
 
 func (h *Handler) GetVenuesBiggestAndSmallest(w http.ResponseWriter, r *http.Request) {
-	data, err := h.service.GetVenuesBiggestAndSmallest()
+	data, err := h.service.GetVenuesBiggestAndSmallest(r.Context())
 	if err != nil {
 		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
 		return
@@ -324,10 +611,22 @@ func (h *Handler) GetStandingsBySeason(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetStandingsBySeason(season)
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	clubID, err := helper.QueryInt(r, "club")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'club'")
+		return
+	}
+
+	data, err := h.service.GetStandingsBySeason(r.Context(), teamID, season, clubID)
 	if err != nil {
 		switch err {
-		case service.ErrStandingNotFound:
+		case service.ErrStandingNotFound, service.ErrStandingClubNotFound, service.ErrTeamNotTracked:
 			helper.WriteError(w, http.StatusNotFound, err.Error())
 		default:
 			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
@@ -339,7 +638,7 @@ func (h *Handler) GetStandingsBySeason(w http.ResponseWriter, r *http.Request) {
 }
 
 
-func (h *Handler) GetFixturesBySeason(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) GetStandingsProgression(w http.ResponseWriter, r *http.Request) {
 	pathValue := r.PathValue("season")
 	season, err := strconv.Atoi(pathValue)
 	if err != nil {
@@ -347,9 +646,20 @@ func (h *Handler) GetFixturesBySeason(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetFixturesBySeason(season)
+	teamID, err := helper.QueryTeamID(r)
 	if err != nil {
-		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetStandingsProgression(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrNoLeagueFixtures, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
 		return
 	}
 
@@ -357,7 +667,7 @@ func (h *Handler) GetFixturesBySeason(w http.ResponseWriter, r *http.Request) {
 }
 
 
-func (h *Handler) GetInjuriesBySeason(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) GetSeasonSummary(w http.ResponseWriter, r *http.Request) {
 	pathValue := r.PathValue("season")
 	season, err := strconv.Atoi(pathValue)
 	if err != nil {
@@ -365,9 +675,20 @@ func (h *Handler) GetInjuriesBySeason(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.service.GetInjuriesBySeason(season)
+	teamID, err := helper.QueryTeamID(r)
 	if err != nil {
-		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetSeasonSummary(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrSeasonSummaryNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
 		return
 	}
 
@@ -375,12 +696,617 @@ func (h *Handler) GetInjuriesBySeason(w http.ResponseWriter, r *http.Request) {
 }
 
 
-func (h *Handler) GetSquad(w http.ResponseWriter, r *http.Request) {
-	data, err := h.service.GetSquad()
+func (h *Handler) GetFixtures(w http.ResponseWriter, r *http.Request) {
+	teamID, err := helper.QueryTeamID(r)
 	if err != nil {
-		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	filter, err := helper.QueryFixtureFilter(r, 0)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q, err := helper.QueryListParams(r, helper.FixtureFilterParams...)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetFixtures(r.Context(), teamID, filter, q)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidListFilter, service.ErrInvalidListSort, service.ErrInvalidFixtureVenue, service.ErrInvalidFixtureResult:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrTeamNotTracked, service.ErrCoachTenureNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
 		return
 	}
 
 	helper.WriteJSON(w, http.StatusOK, data)
-}
\ No newline at end of file
+}
+
+
+func (h *Handler) GetFixturesBySeason(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	filter, err := helper.QueryFixtureFilter(r, season)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q, err := helper.QueryListParams(r, helper.FixtureFilterParams...)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetFixtures(r.Context(), teamID, filter, q)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidListFilter, service.ErrInvalidListSort, service.ErrInvalidFixtureVenue, service.ErrInvalidFixtureResult:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrTeamNotTracked, service.ErrCoachTenureNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetFixtureEvents(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	fixtureID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetFixtureEvents(r.Context(), fixtureID)
+	if err != nil {
+		switch err {
+		case service.ErrFixtureEventsNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetFixtureLineups(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	fixtureID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetFixtureLineups(r.Context(), fixtureID)
+	if err != nil {
+		switch err {
+		case service.ErrFixtureLineupsNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetPlayerAppearances(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	playerID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetPlayerAppearances(r.Context(), playerID)
+	if err != nil {
+		switch err {
+		case service.ErrPlayerAppearancesNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetFixtureStatistics(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	fixtureID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetFixtureStatistics(r.Context(), fixtureID)
+	if err != nil {
+		switch err {
+		case service.ErrFixtureStatisticsNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTeamStatsMatches(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	if err := helper.QueryUnsupported(r, "coach"); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetSeasonMatchStats(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrMatchStatsNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetPlayers(w http.ResponseWriter, r *http.Request) {
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetPlayers(r.Context(), teamID)
+	if err != nil {
+		switch err {
+		case service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetPlayer(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	playerID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetPlayer(r.Context(), playerID)
+	if err != nil {
+		switch err {
+		case service.ErrPlayerNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetPlayerStats(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	playerID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	season, err := strconv.Atoi(r.PathValue("season"))
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	data, err := h.service.GetPlayerStats(r.Context(), playerID, season)
+	if err != nil {
+		switch err {
+		case service.ErrPlayerStatsNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetPlayerTrophies(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	playerID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetPlayerTrophies(r.Context(), playerID)
+	if err != nil {
+		switch err {
+		case service.ErrPlayerTrophiesNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetPlayerSidelined(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	playerID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	data, err := h.service.GetPlayerSidelined(r.Context(), playerID)
+	if err != nil {
+		switch err {
+		case service.ErrPlayerSidelinedNotFound:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTeamTrophies(w http.ResponseWriter, r *http.Request) {
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetTeamTrophies(r.Context(), teamID)
+	if err != nil {
+		switch err {
+		case service.ErrTeamTrophiesNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetLeaderboard(r.Context(), teamID, season, r.PathValue("metric"))
+	if err != nil {
+		switch err {
+		case service.ErrInvalidLeaderboardMetric:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrLeaderboardNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetHeadToHead(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("opponentTeamID")
+	opponentID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'opponentTeamID'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	last, err := helper.QueryInt(r, "last")
+	if err != nil || last < 0 {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'last'")
+		return
+	}
+	if last == 0 {
+		last = 5
+	}
+
+	data, err := h.service.GetHeadToHead(r.Context(), teamID, opponentID, last)
+	if err != nil {
+		switch err {
+		case service.ErrHeadToHeadNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetInjuriesBySeason(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("season")
+	season, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'season'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetInjuriesBySeason(r.Context(), teamID, season)
+	if err != nil {
+		switch err {
+		case service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetSquad(w http.ResponseWriter, r *http.Request) {
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	q, err := helper.QueryListParams(r, "team")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := h.service.GetSquad(r.Context(), teamID, q)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidListFilter, service.ErrInvalidListSort:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetTransfers(w http.ResponseWriter, r *http.Request) {
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	season, err := helper.QueryInt(r, "season")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'season'")
+		return
+	}
+
+	playerID, err := helper.QueryInt(r, "player")
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'player'")
+		return
+	}
+
+	filter := model.TransferFilter{
+		Season: 	season,
+		Direction: 	r.URL.Query().Get("direction"),
+		PlayerID: 	playerID,
+	}
+
+	data, err := h.service.GetTransfers(r.Context(), teamID, filter)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidTransferDirection:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		case service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetCoaches(w http.ResponseWriter, r *http.Request) {
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetCoaches(r.Context(), teamID)
+	if err != nil {
+		switch err {
+		case service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) GetCoach(w http.ResponseWriter, r *http.Request) {
+	pathValue := r.PathValue("id")
+	coachID, err := strconv.Atoi(pathValue)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect path variable for 'id'")
+		return
+	}
+
+	teamID, err := helper.QueryTeamID(r)
+	if err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "incorrect query parameter for 'team'")
+		return
+	}
+
+	data, err := h.service.GetCoach(r.Context(), teamID, coachID)
+	if err != nil {
+		switch err {
+		case service.ErrCoachNotFound, service.ErrTeamNotTracked:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+func (h *Handler) GetTrackedTeams(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.GetTrackedTeams(r.Context())
+	if err != nil {
+		helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
+
+
+func (h *Handler) TrackTeam(w http.ResponseWriter, r *http.Request) {
+	var team model.TrackedTeamDTO
+	if err := json.NewDecoder(r.Body).Decode(&team); err != nil {
+		helper.WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	data, err := h.service.TrackTeam(r.Context(), team)
+	if err != nil {
+		switch err {
+		case service.ErrInvalidTrackedTeam:
+			helper.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusCreated, data)
+}
+
+
+func (h *Handler) GetAPIQuota(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.GetAPIQuota(r.Context())
+	if err != nil {
+		switch err {
+		case service.ErrQuotaNotRecorded:
+			helper.WriteError(w, http.StatusNotFound, err.Error())
+		default:
+			helper.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.WriteJSON(w, http.StatusOK, data)
+}
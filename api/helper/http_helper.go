@@ -2,7 +2,13 @@ package helper
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
 )
 
 func WriteJSON(w http.ResponseWriter, httpStatusCode int, data interface{}) {
@@ -14,4 +20,144 @@ func WriteJSON(w http.ResponseWriter, httpStatusCode int, data interface{}) {
 
 func WriteError(w http.ResponseWriter, httpStatusCode int, message string) {
 	WriteJSON(w, httpStatusCode, map[string]string{"error": message})
-}
\ No newline at end of file
+}
+
+func QueryTeamID(r *http.Request) (int, error) {
+	return QueryInt(r, "team")
+}
+
+
+// QueryInt reads an optional integer query parameter, returning 0 when it is absent.
+func QueryInt(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+
+// QueryDate reads an optional yyyy-mm-dd query parameter, returning the zero time when it is absent.
+func QueryDate(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
+
+
+// QueryList splits an optional comma separated query parameter, returning nil when it is absent.
+func QueryList(r *http.Request, name string) []string {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+
+// QueryInts reads an optional comma separated list of integers.
+func QueryInts(r *http.Request, name string) ([]int, error) {
+	var values []int
+	for _, item := range QueryList(r, name) {
+		value, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+
+type QueryParamError struct {
+	Name string
+}
+
+
+func (e *QueryParamError) Error() string {
+	return fmt.Sprintf("incorrect query parameter for '%s'", e.Name)
+}
+
+
+// QueryUnsupported reports the first of the given query parameters the request
+// sets, for endpoints that cannot honour a filter their siblings accept.
+func QueryUnsupported(r *http.Request, names ...string) error {
+	for _, name := range names {
+		if r.URL.Query().Has(name) {
+			return fmt.Errorf("query parameter '%s' is not supported by this endpoint", name)
+		}
+	}
+	return nil
+}
+
+
+// FixtureFilterParams lists the query parameters QueryFixtureFilter reads.
+var FixtureFilterParams = []string{"team", "coach", "opponent", "venue", "result", "round", "from", "to"}
+
+
+// QueryFixtureFilter reads ?coach=, ?opponent=, ?venue=, ?result=, ?round=, ?from= and ?to=
+// into a filter over the given season, 0 meaning every season.
+func QueryFixtureFilter(r *http.Request, season int) (model.FixtureFilter, error) {
+	filter := model.FixtureFilter{Season: season, Venue: r.URL.Query().Get("venue"), Result: r.URL.Query().Get("result"), Round: r.URL.Query().Get("round")}
+
+	var err error
+	if filter.CoachID, err = QueryInt(r, "coach"); err != nil {
+		return model.FixtureFilter{}, &QueryParamError{Name: "coach"}
+	}
+	if filter.OpponentID, err = QueryInt(r, "opponent"); err != nil {
+		return model.FixtureFilter{}, &QueryParamError{Name: "opponent"}
+	}
+	if filter.From, err = QueryDate(r, "from"); err != nil {
+		return model.FixtureFilter{}, &QueryParamError{Name: "from"}
+	}
+	if filter.To, err = QueryDate(r, "to"); err != nil {
+		return model.FixtureFilter{}, &QueryParamError{Name: "to"}
+	}
+
+	return filter, nil
+}
+
+
+// QueryListParams reads ?limit=, ?offset= and ?sort= and treats every other
+// query parameter, except the ones the endpoint reads itself, as a filter.
+func QueryListParams(r *http.Request, own ...string) (model.ListQuery, error) {
+	limit, err := QueryInt(r, "limit")
+	if err != nil || limit < 0 {
+		return model.ListQuery{}, &QueryParamError{Name: "limit"}
+	}
+
+	offset, err := QueryInt(r, "offset")
+	if err != nil || offset < 0 {
+		return model.ListQuery{}, &QueryParamError{Name: "offset"}
+	}
+
+	skip := map[string]bool{"limit": true, "offset": true, "sort": true}
+	for _, name := range own {
+		skip[name] = true
+	}
+
+	query := model.ListQuery{Limit: limit, Offset: offset, Sort: QueryList(r, "sort"), Filters: map[string][]string{}}
+	for name := range r.URL.Query() {
+		if skip[name] {
+			continue
+		}
+		values := QueryList(r, name)
+		if len(values) == 0 {
+			return model.ListQuery{}, &QueryParamError{Name: name}
+		}
+		query.Filters[name] = values
+	}
+
+	return query, nil
+}
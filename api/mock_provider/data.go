@@ -0,0 +1,604 @@
+package mock_provider
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+
+const (
+	leagueID		= 39
+	leagueName		= "Premier League"
+	leagueCountry	= "England"
+	teamsPerLeague	= 20
+	squadSize		= 25
+	benchSize		= 9
+	substitutions	= 3
+)
+
+
+type mockTeam struct {
+	ID			int
+	Name		string
+	Code		string
+	Founded		int
+	VenueID		int
+	VenueName	string
+	Address		string
+	City		string
+	Capacity	int
+	Strength	float64
+}
+
+
+var mockTeams = []mockTeam{
+	{33, "Manchester United", "MUN", 1878, 556, "Old Trafford", "Sir Matt Busby Way", "Manchester", 76212, 1.25},
+	{50, "Manchester City", "MAC", 1880, 555, "Etihad Stadium", "Rowsley Street", "Manchester", 55097, 1.55},
+	{40, "Liverpool", "LIV", 1892, 550, "Anfield", "Anfield Road", "Liverpool", 61276, 1.5},
+	{42, "Arsenal", "ARS", 1886, 494, "Emirates Stadium", "Queensland Road", "London", 60383, 1.45},
+	{49, "Chelsea", "CHE", 1905, 519, "Stamford Bridge", "Fulham Road", "London", 41841, 1.3},
+	{47, "Tottenham", "TOT", 1882, 593, "Tottenham Hotspur Stadium", "Bill Nicholson Way", "London", 62850, 1.25},
+	{34, "Newcastle", "NEW", 1892, 562, "St. James' Park", "St. James' Street", "Newcastle upon Tyne", 52758, 1.2},
+	{66, "Aston Villa", "AST", 1874, 495, "Villa Park", "Trinity Road", "Birmingham", 42824, 1.15},
+	{51, "Brighton", "BRI", 1901, 508, "American Express Stadium", "Village Way", "Falmer", 31876, 1.05},
+	{48, "West Ham", "WES", 1895, 598, "London Stadium", "Marshgate Lane", "London", 62500, 1.0},
+	{52, "Crystal Palace", "CRY", 1905, 525, "Selhurst Park", "Holmesdale Road", "London", 26309, 0.95},
+	{55, "Brentford", "BRE", 1889, 10503, "Gtech Community Stadium", "Lionel Road South", "Brentford", 17250, 0.95},
+	{65, "Nottingham Forest", "NOT", 1865, 566, "The City Ground", "Pavilion Road", "Nottingham", 30576, 0.9},
+	{39, "Wolves", "WOL", 1877, 600, "Molineux Stadium", "Waterloo Road", "Wolverhampton", 32050, 0.9},
+	{45, "Everton", "EVE", 1878, 8560, "Goodison Park", "Goodison Road", "Liverpool", 39414, 0.85},
+	{36, "Fulham", "FUL", 1879, 535, "Craven Cottage", "Stevenage Road", "London", 25700, 0.85},
+	{35, "Bournemouth", "BOU", 1899, 504, "Vitality Stadium", "Dean Court", "Bournemouth", 11307, 0.8},
+	{46, "Leicester", "LEI", 1884, 547, "King Power Stadium", "Filbert Way", "Leicester", 32262, 0.8},
+	{63, "Leeds", "LEE", 1919, 546, "Elland Road", "Elland Road", "Leeds", 37890, 0.75},
+	{41, "Southampton", "SOU", 1885, 585, "St. Mary's Stadium", "Britannia Road", "Southampton", 32384, 0.7},
+}
+
+
+var mockCountries = []struct {
+	Name	string
+	Code	string
+}{
+	{"England", "GB"},
+	{"France", "FR"},
+	{"Germany", "DE"},
+	{"Italy", "IT"},
+	{"Netherlands", "NL"},
+	{"Portugal", "PT"},
+	{"Spain", "ES"},
+	{"World", ""},
+}
+
+
+var (
+	firstNames	= []string{"James", "Marcus", "Luke", "Harry", "Bruno", "Mason", "Kobbie", "Diogo", "Lisandro", "Andre", "Rasmus", "Alejandro", "Casemiro", "Victor", "Jonny", "Tom", "Aaron", "Scott", "Christian", "Noussair", "Amad", "Leny", "Altay", "Joshua", "Tyrell"}
+	lastNames	= []string{"Walker", "Shaw", "Evans", "Fernandes", "Mount", "Dalot", "Martinez", "Onana", "Hojlund", "Garnacho", "Lindelof", "Heaton", "Wan-Bissaka", "Eriksen", "Mainoo", "Diallo", "Yoro", "Zirkzee", "Ugarte", "Bayindir", "Mazraoui", "De Ligt", "Malacia", "Amass", "Collyer"}
+	positions	= []string{"Goalkeeper", "Goalkeeper", "Goalkeeper", "Defender", "Defender", "Defender", "Defender", "Defender", "Defender", "Defender", "Defender", "Midfielder", "Midfielder", "Midfielder", "Midfielder", "Midfielder", "Midfielder", "Midfielder", "Midfielder", "Attacker", "Attacker", "Attacker", "Attacker", "Attacker", "Attacker"}
+	formations	= []string{"4-2-3-1", "4-3-3", "3-4-3", "4-4-2", "3-5-2"}
+	injuryReasons = []string{"Hamstring Injury", "Knee Injury", "Ankle Injury", "Muscle Injury", "Illness", "Groin Injury", "Suspended"}
+)
+
+
+var coachNames = map[int]string{
+	33: "E. ten Hag",
+	50: "Pep Guardiola",
+	40: "J. Klopp",
+	42: "Mikel Arteta",
+	49: "M. Pochettino",
+	47: "A. Postecoglou",
+	34: "E. Howe",
+	66: "U. Emery",
+	51: "R. De Zerbi",
+	48: "D. Moyes",
+	52: "R. Hodgson",
+	55: "T. Frank",
+	65: "N. Espirito Santo",
+	39: "G. O'Neil",
+	45: "S. Dyche",
+	36: "Marco Silva",
+	35: "A. Iraola",
+	46: "E. Maresca",
+	63: "D. Farke",
+	41: "R. Martin",
+}
+
+
+var minuteBuckets = []struct {
+	Label	string
+	From	int
+	To		int
+}{
+	{"0-15", 0, 15},
+	{"16-30", 16, 30},
+	{"31-45", 31, 45},
+	{"46-60", 46, 60},
+	{"61-75", 61, 75},
+	{"76-90", 76, 90},
+	{"91-105", 91, 105},
+	{"106-120", 106, 120},
+}
+
+
+type mockPlayer struct {
+	ID			int
+	Name		string
+	Age			int
+	Number		int
+	Position	string
+}
+
+
+type mockGoal struct {
+	Minute		int
+	TeamID		int
+	PlayerID	int
+	AssistID	int
+	Penalty		bool
+}
+
+
+type mockCard struct {
+	Minute		int
+	TeamID		int
+	PlayerID	int
+	Red			bool
+}
+
+
+type mockSubstitution struct {
+	Minute		int
+	TeamID		int
+	OutID		int
+	InID		int
+}
+
+
+type mockVAR struct {
+	Minute		int
+	TeamID		int
+	PlayerID	int
+}
+
+
+type mockFixture struct {
+	ID				int
+	Season			int
+	Round			int
+	Timestamp		int64
+	Home			*mockTeam
+	Away			*mockTeam
+	HomeGoals		int
+	AwayGoals		int
+	HalfHome		int
+	HalfAway		int
+	Goals			[]mockGoal
+	Cards			[]mockCard
+	Substitutions	[]mockSubstitution
+	VAR				[]mockVAR
+}
+
+
+type mockInjury struct {
+	Player		mockPlayer
+	Team		*mockTeam
+	Fixture		*mockFixture
+	Reason		string
+}
+
+
+type mockSeason struct {
+	Year		int
+	Fixtures	[]*mockFixture
+	Injuries	[]mockInjury
+}
+
+
+type mockCoach struct {
+	ID		int
+	Name	string
+	Start	string
+	End		string
+}
+
+
+// coachChangeSeason is when every club appointed its current coach, so
+// lineups before it name the former coach and /coachs reports both tenures.
+const (
+	formerCoachSeason	= 2016
+	coachChangeSeason	= 2022
+)
+
+
+func currentCoach(team *mockTeam) mockCoach {
+	return mockCoach{ID: 9000 + team.ID, Name: coachNames[team.ID], Start: fmt.Sprintf("%d-07-01", coachChangeSeason)}
+}
+
+
+func formerCoach(team *mockTeam) mockCoach {
+	return mockCoach{
+		ID: 	9100 + team.ID,
+		Name: 	fmt.Sprintf("%c. %s", firstNames[(team.ID + 5) % len(firstNames)][0], lastNames[(team.ID * 3) % len(lastNames)]),
+		Start: 	fmt.Sprintf("%d-07-01", formerCoachSeason),
+		End: 	fmt.Sprintf("%d-06-30", coachChangeSeason),
+	}
+}
+
+
+func coachFor(team *mockTeam, season int) mockCoach {
+	if season < coachChangeSeason {
+		return formerCoach(team)
+	}
+	return currentCoach(team)
+}
+
+
+type mockPlayerTotals struct {
+	Player			mockPlayer
+	Team			*mockTeam
+	Appearances		int
+	Lineups			int
+	Minutes			int
+	SubstitutesIn	int
+	SubstitutesOut	int
+	Bench			int
+	Goals			int
+	Assists			int
+	PenaltyScored	int
+	Yellow			int
+	Red				int
+}
+
+
+// playerTotals replays every fixture of the team to add up appearances,
+// minutes, goals, assists and cards for each squad player.
+func (s *mockSeason) playerTotals(team *mockTeam) []*mockPlayerTotals {
+	squad := squadFor(team)
+	totals := make([]*mockPlayerTotals, len(squad))
+	byID := make(map[int]*mockPlayerTotals, len(squad))
+	for i, p := range squad {
+		totals[i] = &mockPlayerTotals{Player: p, Team: team}
+		byID[p.ID] = totals[i]
+	}
+
+	for _, f := range s.Fixtures {
+		if !f.involves(team.ID) {
+			continue
+		}
+
+		xi, bench := f.lineup(team)
+		for _, p := range xi {
+			byID[p.ID].Appearances++
+			byID[p.ID].Lineups++
+			byID[p.ID].Minutes += 90
+		}
+		for _, p := range bench {
+			byID[p.ID].Bench++
+		}
+
+		for _, sub := range f.Substitutions {
+			if sub.TeamID != team.ID {
+				continue
+			}
+			byID[sub.OutID].SubstitutesOut++
+			byID[sub.OutID].Minutes -= 90 - sub.Minute
+			byID[sub.InID].SubstitutesIn++
+			byID[sub.InID].Appearances++
+			byID[sub.InID].Minutes += 90 - sub.Minute
+		}
+
+		for _, g := range f.Goals {
+			if g.TeamID != team.ID {
+				continue
+			}
+			byID[g.PlayerID].Goals++
+			if g.Penalty {
+				byID[g.PlayerID].PenaltyScored++
+			}
+			if g.AssistID != 0 {
+				byID[g.AssistID].Assists++
+			}
+		}
+
+		for _, c := range f.Cards {
+			if c.TeamID != team.ID {
+				continue
+			}
+			if c.Red {
+				byID[c.PlayerID].Red++
+			} else {
+				byID[c.PlayerID].Yellow++
+			}
+		}
+	}
+
+	return totals
+}
+
+
+func teamByID(id int) *mockTeam {
+	for i := range mockTeams {
+		if mockTeams[i].ID == id {
+			return &mockTeams[i]
+		}
+	}
+	return nil
+}
+
+
+func squadFor(team *mockTeam) []mockPlayer {
+	players := make([]mockPlayer, squadSize)
+	offset := team.ID % len(firstNames)
+
+	for i := range players {
+		players[i] = mockPlayer{
+			ID: 		team.ID * 1000 + i + 1,
+			Name: 		fmt.Sprintf("%c. %s", firstNames[(i + offset) % len(firstNames)][0], lastNames[(i * 7 + offset) % len(lastNames)]),
+			Age: 		18 + (i * 5 + team.ID) % 17,
+			Number: 	i + 1,
+			Position: 	positions[i],
+		}
+	}
+
+	return players
+}
+
+
+func outfieldPlayer(rng *rand.Rand, team *mockTeam) mockPlayer {
+	squad := squadFor(team)
+	return squad[3 + rng.IntN(len(squad) - 3)]
+}
+
+
+func seasonStart(year int) time.Time {
+	return time.Date(year, time.August, 12, 15, 0, 0, 0, time.UTC)
+}
+
+
+func poisson(rng *rand.Rand, lambda float64) int {
+	limit := math.Exp(-lambda)
+	k, p := 0, 1.0
+	for {
+		p *= rng.Float64()
+		if p <= limit {
+			return k
+		}
+		k++
+	}
+}
+
+
+// generateSeason builds a double round-robin schedule for the league using the
+// circle method and plays every fixture with a seeded goal/card model.
+func generateSeason(seed uint64, year int) *mockSeason {
+	rng := rand.New(rand.NewPCG(seed, uint64(year)))
+	season := &mockSeason{Year: year}
+
+	order := make([]*mockTeam, teamsPerLeague)
+	for i := range order {
+		order[i] = &mockTeams[i]
+	}
+
+	rounds := teamsPerLeague - 1
+	for round := 0; round < rounds * 2; round++ {
+		rotation := round % rounds
+		teams := rotate(order, rotation)
+
+		for match := 0; match < teamsPerLeague / 2; match++ {
+			home, away := teams[match], teams[teamsPerLeague - 1 - match]
+			if (match == 0 && rotation % 2 == 1) != (round >= rounds) {
+				home, away = away, home
+			}
+
+			kickOff := seasonStart(year).AddDate(0, 0, 7 * round).Add(time.Duration(match % 4) * 2 * time.Hour)
+			fixture := &mockFixture{
+				ID: 		year * 1000 + round * 10 + match,
+				Season: 	year,
+				Round: 		round + 1,
+				Timestamp: 	kickOff.Unix(),
+				Home: 		home,
+				Away: 		away,
+			}
+			playFixture(rng, fixture)
+			season.Fixtures = append(season.Fixtures, fixture)
+		}
+	}
+
+	for i := range mockTeams {
+		team := &mockTeams[i]
+		for n := 0; n < 6; n++ {
+			var fixture *mockFixture
+			for fixture == nil || (fixture.Home.ID != team.ID && fixture.Away.ID != team.ID) {
+				fixture = season.Fixtures[rng.IntN(len(season.Fixtures))]
+			}
+			season.Injuries = append(season.Injuries, mockInjury{
+				Player: 	outfieldPlayer(rng, team),
+				Team: 		team,
+				Fixture: 	fixture,
+				Reason: 	injuryReasons[rng.IntN(len(injuryReasons))],
+			})
+		}
+	}
+
+	return season
+}
+
+
+func rotate(teams []*mockTeam, by int) []*mockTeam {
+	rotated := make([]*mockTeam, len(teams))
+	rotated[0] = teams[0]
+
+	rest := len(teams) - 1
+	for i := 0; i < rest; i++ {
+		rotated[1 + (i + by) % rest] = teams[1 + i]
+	}
+
+	return rotated
+}
+
+
+func playFixture(rng *rand.Rand, f *mockFixture) {
+	f.HomeGoals = poisson(rng, 1.45 * f.Home.Strength / f.Away.Strength)
+	f.AwayGoals = poisson(rng, 1.15 * f.Away.Strength / f.Home.Strength)
+
+	for _, side := range []struct {
+		team	*mockTeam
+		goals	int
+	}{{f.Home, f.HomeGoals}, {f.Away, f.AwayGoals}} {
+		xi, bench := f.lineup(side.team)
+
+		for g := 0; g < side.goals; g++ {
+			scorer := xi[1 + rng.IntN(len(xi) - 1)]
+			goal := mockGoal{
+				Minute: 	1 + rng.IntN(94),
+				TeamID: 	side.team.ID,
+				PlayerID: 	scorer.ID,
+				Penalty: 	rng.IntN(10) == 0,
+			}
+			if assist := xi[1 + rng.IntN(len(xi) - 1)]; !goal.Penalty && assist.ID != scorer.ID && rng.IntN(10) < 7 {
+				goal.AssistID = assist.ID
+			}
+			f.Goals = append(f.Goals, goal)
+
+			if goal.Minute <= 45 {
+				if side.team == f.Home {
+					f.HalfHome++
+				} else {
+					f.HalfAway++
+				}
+			}
+		}
+
+		for c := poisson(rng, 1.8); c > 0; c-- {
+			f.Cards = append(f.Cards, mockCard{
+				Minute: 	1 + rng.IntN(94),
+				TeamID: 	side.team.ID,
+				PlayerID: 	xi[1 + rng.IntN(len(xi) - 1)].ID,
+				Red: 		rng.IntN(25) == 0,
+			})
+		}
+
+		for k := 0; k < substitutions; k++ {
+			f.Substitutions = append(f.Substitutions, mockSubstitution{
+				Minute: 	55 + k * 10 + rng.IntN(10),
+				TeamID: 	side.team.ID,
+				OutID: 		xi[len(xi) - 1 - k].ID,
+				InID: 		bench[1 + k].ID,
+			})
+		}
+
+		if rng.IntN(8) == 0 {
+			f.VAR = append(f.VAR, mockVAR{
+				Minute: 	1 + rng.IntN(94),
+				TeamID: 	side.team.ID,
+				PlayerID: 	xi[1 + rng.IntN(len(xi) - 1)].ID,
+			})
+		}
+	}
+
+	sort.Slice(f.Goals, func(i, j int) bool { return f.Goals[i].Minute < f.Goals[j].Minute })
+	sort.Slice(f.Cards, func(i, j int) bool { return f.Cards[i].Minute < f.Cards[j].Minute })
+	sort.Slice(f.Substitutions, func(i, j int) bool { return f.Substitutions[i].Minute < f.Substitutions[j].Minute })
+}
+
+
+func (f *mockFixture) formation(team *mockTeam) string {
+	return formations[(f.ID + team.ID) % len(formations)]
+}
+
+
+// lineup picks the starting eleven for the fixture formation from the squad
+// positions (goalkeepers 0-2, defenders 3-10, midfielders 11-18, attackers
+// 19-24), rotating through each position group by fixture.
+func (f *mockFixture) lineup(team *mockTeam) ([]mockPlayer, []mockPlayer) {
+	squad := squadFor(team)
+
+	lines := strings.Split(f.formation(team), "-")
+	defenders, _ := strconv.Atoi(lines[0])
+	attackers, _ := strconv.Atoi(lines[len(lines) - 1])
+	midfielders := 10 - defenders - attackers
+
+	xi := []mockPlayer{squad[0]}
+	picked := map[int]bool{0: true}
+	for _, group := range []struct {
+		from, to, count int
+	}{{3, 10, defenders}, {11, 18, midfielders}, {19, 24, attackers}} {
+		size := group.to - group.from + 1
+		for i := 0; i < group.count; i++ {
+			index := group.from + (f.ID + i) % size
+			xi = append(xi, squad[index])
+			picked[index] = true
+		}
+	}
+
+	bench := []mockPlayer{squad[1]}
+	for i := 3; i < len(squad) && len(bench) < benchSize; i++ {
+		if !picked[i] {
+			bench = append(bench, squad[i])
+		}
+	}
+
+	return xi, bench
+}
+
+
+func (f *mockFixture) involves(teamID int) bool {
+	return f.Home.ID == teamID || f.Away.ID == teamID
+}
+
+
+func (f *mockFixture) goalsFor(teamID int) (int, int) {
+	if f.Home.ID == teamID {
+		return f.HomeGoals, f.AwayGoals
+	}
+	return f.AwayGoals, f.HomeGoals
+}
+
+
+func (f *mockFixture) result(teamID int) string {
+	scored, conceded := f.goalsFor(teamID)
+	switch {
+	case scored > conceded:
+		return "W"
+	case scored < conceded:
+		return "L"
+	default:
+		return "D"
+	}
+}
+
+
+func (f *mockFixture) date() string {
+	return time.Unix(f.Timestamp, 0).UTC().Format("2006-01-02T15:04:05+00:00")
+}
+
+
+func minuteBucket(minute int) string {
+	for _, b := range minuteBuckets {
+		if minute >= b.From && minute <= b.To {
+			return b.Label
+		}
+	}
+	return minuteBuckets[len(minuteBuckets) - 1].Label
+}
+
+
+func percentage(part, total int) *string {
+	if total == 0 {
+		return nil
+	}
+	value := fmt.Sprintf("%.2f%%", float64(part) * 100 / float64(total))
+	return &value
+}
+
+
+func average(total, played int) string {
+	if played == 0 {
+		return "0.0"
+	}
+	return fmt.Sprintf("%.1f", float64(total) / float64(played))
+}
@@ -0,0 +1,1108 @@
+package mock_provider
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+)
+
+
+const (
+	firstSeason		= 2010
+	currentSeason	= 2025
+	playersPageSize	= 20
+	topPlayersSize	= 20
+)
+
+
+func countriesResponse() []model.CountryDTO {
+	countries := make([]model.CountryDTO, len(mockCountries))
+	for i, c := range mockCountries {
+		countries[i] = model.CountryDTO{Name: c.Name, Code: c.Code}
+	}
+	return countries
+}
+
+
+func leaguesResponse(teamID int) []model.LeagueDTO {
+	if teamByID(teamID) == nil {
+		return []model.LeagueDTO{}
+	}
+
+	var seasons []model.SeasonDTO
+	for year := firstSeason; year <= currentSeason; year++ {
+		seasons = append(seasons, model.SeasonDTO{
+			Year: 		year,
+			Start: 		seasonStart(year).Format(time.DateOnly),
+			End: 		time.Date(year + 1, time.May, 25, 0, 0, 0, 0, time.UTC).Format(time.DateOnly),
+			Current: 	year == currentSeason,
+		})
+	}
+
+	return []model.LeagueDTO{
+		{
+			League: 	model.LeagueInfoDTO{LeagueID: leagueID, Name: leagueName, Type: "League"},
+			Country: 	model.CountryDTO{Name: leagueCountry, Code: "GB"},
+			Seasons: 	seasons,
+		},
+		{
+			League: 	model.LeagueInfoDTO{LeagueID: 45, Name: "FA Cup", Type: "Cup"},
+			Country: 	model.CountryDTO{Name: leagueCountry, Code: "GB"},
+			Seasons: 	seasons,
+		},
+	}
+}
+
+
+func teamsResponse(teamID int) []model.TeamInfoDTO {
+	team := teamByID(teamID)
+	if team == nil {
+		return []model.TeamInfoDTO{}
+	}
+
+	return []model.TeamInfoDTO{{
+		Team: 	model.TeamDTO{
+			TeamID: 	team.ID,
+			TeamName: 	team.Name,
+			Code: 		team.Code,
+			Country: 	leagueCountry,
+			Founded: 	team.Founded,
+		},
+		Venue: 	venueDTO(team),
+	}}
+}
+
+
+func venueDTO(team *mockTeam) model.VenueDTO {
+	return model.VenueDTO{
+		VenueID: 	team.VenueID,
+		VenueName: 	team.VenueName,
+		Address: 	team.Address,
+		City: 		team.City,
+		Capacity: 	team.Capacity,
+		Surface: 	"grass",
+	}
+}
+
+
+func venuesResponse(country string) []model.VenueDTO {
+	if !strings.EqualFold(country, leagueCountry) {
+		return []model.VenueDTO{}
+	}
+
+	venues := make([]model.VenueDTO, len(mockTeams))
+	for i := range mockTeams {
+		venues[i] = venueDTO(&mockTeams[i])
+	}
+	return venues
+}
+
+
+func fixtureDTO(f *mockFixture) model.FixtureDTO {
+	elapsed := int64(45 * 60)
+	first, second := f.Timestamp, f.Timestamp + elapsed + 15 * 60
+
+	homeWinner, awayWinner := winners(f)
+
+	return model.FixtureDTO{
+		Fixture: 	model.FixtureInfo{
+			ID: 		f.ID,
+			Referee: 	"M. Oliver, England",
+			Timezone: 	"UTC",
+			Date: 		f.date(),
+			Timestamp: 	f.Timestamp,
+			Periods: 	model.FixturePeriods{First: &first, Second: &second},
+			Venue: 		model.FixtureVenue{ID: f.Home.VenueID, Name: f.Home.VenueName, City: f.Home.City},
+			Status: 	model.FixtureStatus{Long: "Match Finished", Short: "FT", Elapsed: 90},
+		},
+		League: 	model.FixtureLeague{
+			ID: 		leagueID,
+			Name: 		leagueName,
+			Country: 	leagueCountry,
+			Season: 	f.Season,
+			Round: 		fmt.Sprintf("Regular Season - %d", f.Round),
+			Standings: 	true,
+		},
+		Teams: 		model.FixtureTeams{
+			Home: model.FixtureTeam{ID: f.Home.ID, Name: f.Home.Name, Winner: homeWinner},
+			Away: model.FixtureTeam{ID: f.Away.ID, Name: f.Away.Name, Winner: awayWinner},
+		},
+		Goals: 		model.FixtureGoals{Home: intPtr(f.HomeGoals), Away: intPtr(f.AwayGoals)},
+		Score: 		model.FixtureScore{
+			Halftime: 	model.FixtureGoals{Home: intPtr(f.HalfHome), Away: intPtr(f.HalfAway)},
+			Fulltime: 	model.FixtureGoals{Home: intPtr(f.HomeGoals), Away: intPtr(f.AwayGoals)},
+		},
+	}
+}
+
+
+func winners(f *mockFixture) (*bool, *bool) {
+	if f.HomeGoals == f.AwayGoals {
+		return nil, nil
+	}
+	home := f.HomeGoals > f.AwayGoals
+	away := !home
+	return &home, &away
+}
+
+
+func intPtr(v int) *int {
+	return &v
+}
+
+
+func fixturesResponse(season *mockSeason, teamID int) []model.FixtureDTO {
+	fixtures := []model.FixtureDTO{}
+	for _, f := range season.Fixtures {
+		if teamID != 0 && !f.involves(teamID) {
+			continue
+		}
+		fixtures = append(fixtures, fixtureDTO(f))
+	}
+	return fixtures
+}
+
+
+// headToHeadResponse lists every generated league meeting of two teams, newest
+// season first, from firstSeason up to the current one.
+func headToHeadResponse(season func(year int) *mockSeason, teamID, opponentID int) []model.FixtureDTO {
+	fixtures := []model.FixtureDTO{}
+	for year := currentSeason; year >= firstSeason; year-- {
+		for _, f := range season(year).Fixtures {
+			if f.involves(teamID) && f.involves(opponentID) {
+				fixtures = append(fixtures, fixtureDTO(f))
+			}
+		}
+	}
+	return fixtures
+}
+
+
+func playerByID(id int) *mockPlayer {
+	team := teamByID(id / 1000)
+	if team == nil {
+		return nil
+	}
+
+	for _, p := range squadFor(team) {
+		if p.ID == id {
+			return &p
+		}
+	}
+	return nil
+}
+
+
+func eventPlayer(id int) model.FixtureEventPlayer {
+	player := playerByID(id)
+	if player == nil {
+		return model.FixtureEventPlayer{}
+	}
+	return model.FixtureEventPlayer{ID: &player.ID, Name: &player.Name}
+}
+
+
+func eventTeam(f *mockFixture, teamID int) model.FixtureTeam {
+	if f.Home.ID == teamID {
+		return model.FixtureTeam{ID: f.Home.ID, Name: f.Home.Name}
+	}
+	return model.FixtureTeam{ID: f.Away.ID, Name: f.Away.Name}
+}
+
+
+func fixtureEventsResponse(f *mockFixture) []model.FixtureEventDTO {
+	events := []model.FixtureEventDTO{}
+
+	for _, g := range f.Goals {
+		detail := "Normal Goal"
+		if g.Penalty {
+			detail = "Penalty"
+		}
+		events = append(events, model.FixtureEventDTO{
+			Time: 		model.FixtureEventTime{Elapsed: g.Minute},
+			Team: 		eventTeam(f, g.TeamID),
+			Player: 	eventPlayer(g.PlayerID),
+			Assist: 	eventPlayer(g.AssistID),
+			Type: 		"Goal",
+			Detail: 	detail,
+		})
+	}
+
+	for _, c := range f.Cards {
+		detail := "Yellow Card"
+		if c.Red {
+			detail = "Red Card"
+		}
+		comments := "Foul"
+		events = append(events, model.FixtureEventDTO{
+			Time: 		model.FixtureEventTime{Elapsed: c.Minute},
+			Team: 		eventTeam(f, c.TeamID),
+			Player: 	eventPlayer(c.PlayerID),
+			Type: 		"Card",
+			Detail: 	detail,
+			Comments: 	&comments,
+		})
+	}
+
+	made := map[int]int{}
+	for _, sub := range f.Substitutions {
+		made[sub.TeamID]++
+		events = append(events, model.FixtureEventDTO{
+			Time: 		model.FixtureEventTime{Elapsed: sub.Minute},
+			Team: 		eventTeam(f, sub.TeamID),
+			Player: 	eventPlayer(sub.OutID),
+			Assist: 	eventPlayer(sub.InID),
+			Type: 		"subst",
+			Detail: 	fmt.Sprintf("Substitution %d", made[sub.TeamID]),
+		})
+	}
+
+	for _, v := range f.VAR {
+		events = append(events, model.FixtureEventDTO{
+			Time: 		model.FixtureEventTime{Elapsed: v.Minute},
+			Team: 		eventTeam(f, v.TeamID),
+			Player: 	eventPlayer(v.PlayerID),
+			Type: 		"Var",
+			Detail: 	"Goal cancelled",
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Elapsed < events[j].Time.Elapsed
+	})
+
+	for i := range events {
+		if events[i].Time.Elapsed > 90 {
+			extra := events[i].Time.Elapsed - 90
+			events[i].Time.Elapsed = 90
+			events[i].Time.Extra = &extra
+		}
+	}
+
+	return events
+}
+
+
+var positionCodes = map[string]string{
+	"Goalkeeper": 	"G",
+	"Defender": 	"D",
+	"Midfielder": 	"M",
+	"Attacker": 	"F",
+}
+
+
+func lineupEntry(player mockPlayer, grid *string) model.FixtureLineupEntry {
+	return model.FixtureLineupEntry{Player: model.FixtureLineupPlayerInfo{
+		ID: 		player.ID,
+		Name: 		player.Name,
+		Number: 	player.Number,
+		Pos: 		positionCodes[player.Position],
+		Grid: 		grid,
+	}}
+}
+
+
+func fixtureLineupsResponse(f *mockFixture) []model.FixtureLineupDTO {
+	lineups := []model.FixtureLineupDTO{}
+
+	for _, team := range []*mockTeam{f.Home, f.Away} {
+		xi, bench := f.lineup(team)
+		coach := coachFor(team, f.Season)
+		formation := f.formation(team)
+
+		lineup := model.FixtureLineupDTO{
+			Team: 		model.FixtureTeam{ID: team.ID, Name: team.Name},
+			Coach: 		model.FixtureLineupCoach{ID: &coach.ID, Name: &coach.Name},
+			Formation: 	formation,
+		}
+
+		// grid is "row:column" with the goalkeeper on row 1 and one row per
+		// formation line, filled in the order the eleven was picked
+		rows := []int{1}
+		for _, line := range strings.Split(formation, "-") {
+			size, _ := strconv.Atoi(line)
+			rows = append(rows, size)
+		}
+
+		player := 0
+		for row, size := range rows {
+			for column := 1; column <= size; column++ {
+				grid := fmt.Sprintf("%d:%d", row + 1, column)
+				lineup.StartXI = append(lineup.StartXI, lineupEntry(xi[player], &grid))
+				player++
+			}
+		}
+
+		for _, p := range bench {
+			lineup.Substitutes = append(lineup.Substitutes, lineupEntry(p, nil))
+		}
+
+		lineups = append(lineups, lineup)
+	}
+
+	return lineups
+}
+
+
+// fixtureStatisticsResponse derives match statistics from the fixture result
+// and team strengths with its own per fixture random source, so they stay
+// stable without being part of the season generation.
+func fixtureStatisticsResponse(seed uint64, f *mockFixture) []model.FixtureStatisticsDTO {
+	rng := rand.New(rand.NewPCG(seed, uint64(f.ID)))
+
+	homeShare := f.Home.Strength / (f.Home.Strength + f.Away.Strength)
+	homePossession := min(max(int(math.Round(homeShare * 100)) + 3 + rng.IntN(11) - 5, 25), 75)
+
+	statistics := []model.FixtureStatisticsDTO{}
+	for _, side := range []struct {
+		team		*mockTeam
+		goals		int
+		possession	int
+	}{
+		{f.Home, f.HomeGoals, homePossession},
+		{f.Away, f.AwayGoals, 100 - homePossession},
+	} {
+		onGoal := side.goals + rng.IntN(5)
+		offGoal := 2 + rng.IntN(7)
+		blocked := rng.IntN(5)
+		total := onGoal + offGoal + blocked
+		insideBox := total * (55 + rng.IntN(25)) / 100
+		passes := 250 + side.possession * 6 + rng.IntN(80)
+		passesPct := 72 + side.possession / 5 + rng.IntN(6)
+		accurate := passes * passesPct / 100
+		saves := rng.IntN(6)
+		expectedGoals := fmt.Sprintf("%.2f", float64(onGoal) * 0.3 + float64(offGoal) * 0.05 + rng.Float64() * 0.4)
+
+		yellow, red := 0, 0
+		for _, c := range f.Cards {
+			if c.TeamID != side.team.ID {
+				continue
+			}
+			if c.Red {
+				red++
+			} else {
+				yellow++
+			}
+		}
+
+		statistics = append(statistics, model.FixtureStatisticsDTO{
+			Team: 		model.FixtureTeam{ID: side.team.ID, Name: side.team.Name},
+			Statistics: []model.FixtureStatisticDTO{
+				{Type: "Shots on Goal", Value: onGoal},
+				{Type: "Shots off Goal", Value: offGoal},
+				{Type: "Total Shots", Value: total},
+				{Type: "Blocked Shots", Value: blocked},
+				{Type: "Shots insidebox", Value: insideBox},
+				{Type: "Shots outsidebox", Value: total - insideBox},
+				{Type: "Fouls", Value: 7 + rng.IntN(9)},
+				{Type: "Corner Kicks", Value: 1 + rng.IntN(10)},
+				{Type: "Offsides", Value: rng.IntN(5)},
+				{Type: "Ball Possession", Value: fmt.Sprintf("%d%%", side.possession)},
+				{Type: "Yellow Cards", Value: yellow},
+				{Type: "Red Cards", Value: red},
+				{Type: "Goalkeeper Saves", Value: saves},
+				{Type: "Total passes", Value: passes},
+				{Type: "Passes accurate", Value: accurate},
+				{Type: "Passes %", Value: fmt.Sprintf("%d%%", passesPct)},
+				{Type: "expected_goals", Value: expectedGoals},
+			},
+		})
+	}
+
+	return statistics
+}
+
+
+func injuriesResponse(season *mockSeason, teamID int) []model.InjuryDTO {
+	injuries := []model.InjuryDTO{}
+	for _, inj := range season.Injuries {
+		if inj.Team.ID != teamID {
+			continue
+		}
+		injuries = append(injuries, model.InjuryDTO{
+			Player: 	model.InjuryPlayerDTO{ID: inj.Player.ID, Name: inj.Player.Name, Type: "Missing Fixture", Reason: inj.Reason},
+			Team: 		model.InjuryTeamDTO{ID: inj.Team.ID, Name: inj.Team.Name},
+			Fixture: 	model.InjuryFixtureDTO{ID: inj.Fixture.ID, Timezone: "UTC", Date: inj.Fixture.date(), Timestamp: inj.Fixture.Timestamp},
+			League: 	model.InjuryLeagueDTO{ID: leagueID, Season: season.Year, Name: leagueName, Country: leagueCountry},
+		})
+	}
+	return injuries
+}
+
+
+func squadsResponse(teamID int) []model.SquadDTO {
+	team := teamByID(teamID)
+	if team == nil {
+		return []model.SquadDTO{}
+	}
+
+	var players []model.SquadPlayer
+	for _, p := range squadFor(team) {
+		players = append(players, model.SquadPlayer{ID: p.ID, Name: p.Name, Age: p.Age, Number: p.Number, Position: p.Position})
+	}
+
+	return []model.SquadDTO{{
+		Team: 		model.SquadTeam{ID: team.ID, Name: team.Name},
+		Players: 	players,
+	}}
+}
+
+
+var transferTypes = []string{"€ 12.5M", "Free", "€ 45M", "€ 3M", "€ 80M", "N/A", "€ 22M", "Loan"}
+
+
+func transferTeam(team *mockTeam) model.FixtureTeam {
+	return model.FixtureTeam{ID: team.ID, Name: team.Name, Logo: fmt.Sprintf("https://media.api-sports.io/football/teams/%d.png", team.ID)}
+}
+
+
+func transferEntry(date string, kind *string, in, out *mockTeam) model.TransferEntryDTO {
+	return model.TransferEntryDTO{
+		Date: 	date,
+		Type: 	kind,
+		Teams: 	model.TransferTeamsDTO{In: transferTeam(in), Out: transferTeam(out)},
+	}
+}
+
+
+// transfersResponse gives every squad player the move that brought them to the
+// team, sends some of them out on loan and back, and adds a handful of former
+// players who were later sold so both directions are covered.
+func transfersResponse(teamID int) []model.TransferDTO {
+	team := teamByID(teamID)
+	if team == nil {
+		return []model.TransferDTO{}
+	}
+
+	other := func(n int) *mockTeam {
+		candidate := &mockTeams[n % len(mockTeams)]
+		if candidate.ID == team.ID {
+			candidate = &mockTeams[(n + 1) % len(mockTeams)]
+		}
+		return candidate
+	}
+
+	date := func(year, n int) string {
+		if n % 3 == 0 {
+			return fmt.Sprintf("%d-01-%02d", year + 1, 2 + n % 28)
+		}
+		return fmt.Sprintf("%d-07-%02d", year, 1 + n % 30)
+	}
+
+	transfers := []model.TransferDTO{}
+	for i, p := range squadFor(team) {
+		joined := currentSeason - (i * 5 + team.ID) % 8
+		kind := transferTypes[(i + team.ID) % len(transferTypes)]
+		entries := []model.TransferEntryDTO{transferEntry(date(joined, i), &kind, team, other(team.ID + i * 3))}
+
+		if i % 6 == 5 && joined + 2 <= currentSeason {
+			loan, back := "Loan", "Back from Loan"
+			loanClub := other(team.ID + i * 7)
+			entries = append(entries,
+				transferEntry(fmt.Sprintf("%d-08-%02d", joined + 1, 1 + i), &loan, loanClub, team),
+				transferEntry(fmt.Sprintf("%d-06-30", joined + 2), &back, team, loanClub),
+			)
+		}
+
+		transfers = append(transfers, model.TransferDTO{
+			Player: 	model.TransferPlayerDTO{ID: p.ID, Name: p.Name},
+			Update: 	fmt.Sprintf("%d-06-01T00:00:00+00:00", currentSeason),
+			Transfers: 	entries,
+		})
+	}
+
+	for k := 0; k < 6; k++ {
+		id := team.ID * 1000 + 100 + k
+		joined := currentSeason - 6 + k % 3
+		left := joined + 1 + k % 2
+		fee, sale := transferTypes[(id + 2) % len(transferTypes)], transferTypes[(id + 5) % len(transferTypes)]
+
+		transfers = append(transfers, model.TransferDTO{
+			Player: 	model.TransferPlayerDTO{ID: id, Name: fmt.Sprintf("%c. %s", firstNames[(id + 3) % len(firstNames)][0], lastNames[(id * 11) % len(lastNames)])},
+			Update: 	fmt.Sprintf("%d-06-01T00:00:00+00:00", currentSeason),
+			Transfers: 	[]model.TransferEntryDTO{
+				transferEntry(date(joined, k + 1), &fee, team, other(id)),
+				transferEntry(date(left, k + 2), &sale, other(id + 5), team),
+			},
+		})
+	}
+
+	return transfers
+}
+
+
+func coachDTO(coach mockCoach, team *mockTeam, previous *mockTeam, previousStart string) model.CoachDTO {
+	names := strings.SplitN(coach.Name, " ", 2)
+	age := 42 + coach.ID % 20
+	birth := fmt.Sprintf("%d-%02d-%02d", currentSeason - age, 1 + coach.ID % 12, 1 + coach.ID % 28)
+	nationality := mockCountries[coach.ID % (len(mockCountries) - 1)].Name
+
+	var end *string
+	if coach.End != "" {
+		end = &coach.End
+	}
+	previousEnd := coach.Start[:4] + "-06-30"
+
+	return model.CoachDTO{
+		ID: 			coach.ID,
+		Name: 			coach.Name,
+		Firstname: 		names[0],
+		Lastname: 		names[len(names) - 1],
+		Age: 			&age,
+		Birth: 			model.PlayerBirthDTO{Date: &birth, Country: &nationality},
+		Nationality: 	&nationality,
+		Photo: 			fmt.Sprintf("https://media.api-sports.io/football/coachs/%d.png", coach.ID),
+		Team: 			transferTeam(team),
+		Career: 		[]model.CoachCareerDTO{
+			{Team: transferTeam(team), Start: coach.Start, End: end},
+			{Team: transferTeam(previous), Start: previousStart, End: &previousEnd},
+		},
+	}
+}
+
+
+// coachsResponse lists the current and former coach of a team, each with the
+// club they managed before arriving.
+func coachsResponse(teamID int) []model.CoachDTO {
+	team := teamByID(teamID)
+	if team == nil {
+		return []model.CoachDTO{}
+	}
+
+	index := 0
+	for i := range mockTeams {
+		if mockTeams[i].ID == team.ID {
+			index = i
+		}
+	}
+	previous := func(offset int) *mockTeam {
+		return &mockTeams[(index + offset) % len(mockTeams)]
+	}
+
+	return []model.CoachDTO{
+		coachDTO(currentCoach(team), team, previous(7), "2018-07-01"),
+		coachDTO(formerCoach(team), team, previous(13), "2012-07-01"),
+	}
+}
+
+
+// trophiesResponse awards the title and runner-up spot of each generated table
+// plus a cup drawn per season, for the seasons spent at a club.
+func trophiesResponse(season func(year int) *mockSeason, team *mockTeam, from, to int) []model.TrophyDTO {
+	trophies := []model.TrophyDTO{}
+
+	for year := to; year >= from; year-- {
+		label := fmt.Sprintf("%d/%d", year, year + 1)
+
+		for rank, row := range leagueTable(season(year))[:2] {
+			if row.team.ID != team.ID {
+				continue
+			}
+			place := "Winner"
+			if rank == 1 {
+				place = "2nd Place"
+			}
+			trophies = append(trophies, model.TrophyDTO{League: leagueName, Country: leagueCountry, Season: label, Place: place})
+		}
+
+		if mockTeams[(year * 7 + 3) % len(mockTeams)].ID == team.ID {
+			trophies = append(trophies, model.TrophyDTO{League: "FA Cup", Country: leagueCountry, Season: label, Place: "Winner"})
+		}
+	}
+
+	return trophies
+}
+
+
+func sidelinedResponse(playerID int) []model.SidelinedDTO {
+	if playerByID(playerID) == nil {
+		return []model.SidelinedDTO{}
+	}
+
+	sidelined := []model.SidelinedDTO{}
+	for k := 0; k < playerID % 4; k++ {
+		start := seasonStart(currentSeason - 1 - k).AddDate(0, 0, (playerID * 13 + k * 47) % 200)
+		var end *string
+		if k > 0 || playerID % 7 != 0 {
+			date := start.AddDate(0, 0, 5 + (playerID * (k + 1)) % 40).Format(time.DateOnly)
+			end = &date
+		}
+
+		sidelined = append(sidelined, model.SidelinedDTO{
+			Type: 	injuryReasons[(playerID + k) % len(injuryReasons)],
+			Start: 	start.Format(time.DateOnly),
+			End: 	end,
+		})
+	}
+
+	return sidelined
+}
+
+
+type tableRow struct {
+	team	*mockTeam
+	all		model.StandingStats
+	home	model.StandingStats
+	away	model.StandingStats
+	form	string
+}
+
+
+func (r *tableRow) points() int {
+	return r.all.Win * 3 + r.all.Draw
+}
+
+
+func (r *tableRow) goalsDiff() int {
+	return r.all.Goals.For - r.all.Goals.Against
+}
+
+
+func addResult(stats *model.StandingStats, scored, conceded int) {
+	stats.Played++
+	stats.Goals.For += scored
+	stats.Goals.Against += conceded
+
+	switch {
+	case scored > conceded:
+		stats.Win++
+	case scored < conceded:
+		stats.Lose++
+	default:
+		stats.Draw++
+	}
+}
+
+
+func leagueTable(season *mockSeason) []*tableRow {
+	rows := make(map[int]*tableRow, len(mockTeams))
+	for i := range mockTeams {
+		rows[mockTeams[i].ID] = &tableRow{team: &mockTeams[i]}
+	}
+
+	for _, f := range season.Fixtures {
+		home, away := rows[f.Home.ID], rows[f.Away.ID]
+
+		addResult(&home.all, f.HomeGoals, f.AwayGoals)
+		addResult(&home.home, f.HomeGoals, f.AwayGoals)
+		addResult(&away.all, f.AwayGoals, f.HomeGoals)
+		addResult(&away.away, f.AwayGoals, f.HomeGoals)
+
+		home.form = f.result(f.Home.ID) + home.form
+		away.form = f.result(f.Away.ID) + away.form
+	}
+
+	table := make([]*tableRow, 0, len(rows))
+	for _, row := range rows {
+		if len(row.form) > 5 {
+			row.form = row.form[:5]
+		}
+		table = append(table, row)
+	}
+
+	sort.Slice(table, func(i, j int) bool {
+		a, b := table[i], table[j]
+		if a.points() != b.points() {
+			return a.points() > b.points()
+		}
+		if a.goalsDiff() != b.goalsDiff() {
+			return a.goalsDiff() > b.goalsDiff()
+		}
+		if a.all.Goals.For != b.all.Goals.For {
+			return a.all.Goals.For > b.all.Goals.For
+		}
+		return a.team.Name < b.team.Name
+	})
+
+	return table
+}
+
+
+func rankDescription(rank int) string {
+	switch {
+	case rank <= 4:
+		return "Promotion - Champions League (Group Stage: )"
+	case rank == 5:
+		return "Promotion - Europa League (Group Stage: )"
+	case rank >= 18:
+		return "Relegation - Championship"
+	default:
+		return ""
+	}
+}
+
+
+func standingsResponse(season *mockSeason, teamID int) []model.StandingDTO {
+	update := time.Date(season.Year + 1, time.May, 26, 0, 0, 0, 0, time.UTC).Format("2006-01-02T15:04:05+00:00")
+
+	var entries []model.StandingEntry
+	for i, row := range leagueTable(season) {
+		if teamID != 0 && row.team.ID != teamID {
+			continue
+		}
+		entries = append(entries, model.StandingEntry{
+			Rank: 			i + 1,
+			Team: 			model.StandingTeam{ID: row.team.ID, Name: row.team.Name},
+			Points: 		row.points(),
+			GoalsDiff: 		row.goalsDiff(),
+			Group: 			leagueName,
+			Form: 			row.form,
+			Status: 		"same",
+			Description: 	rankDescription(i + 1),
+			All: 			row.all,
+			Home: 			row.home,
+			Away: 			row.away,
+			Update: 		update,
+		})
+	}
+
+	if len(entries) == 0 {
+		return []model.StandingDTO{}
+	}
+
+	return []model.StandingDTO{{
+		StandingInfo: model.StandingInfoDTO{
+			ID: 		leagueID,
+			Name: 		leagueName,
+			Country: 	leagueCountry,
+			Season: 	season.Year,
+			Standings: 	[][]model.StandingEntry{entries},
+		},
+	}}
+}
+
+
+func teamStatisticsResponse(season *mockSeason, team *mockTeam) model.TeamStatsInfoDTO {
+	stats := model.TeamStatsInfoDTO{
+		League: 	model.TeamLeagueDTO{TeamID: leagueID, Name: leagueName, Country: leagueCountry, Season: season.Year},
+		Team: 		model.ManchesterUnitedTeam{ID: team.ID, Name: team.Name},
+	}
+
+	goalsFor := map[string]int{}
+	goalsAgainst := map[string]int{}
+	yellow := map[string]int{}
+	red := map[string]int{}
+	underOverFor := map[string]model.GoalsUnderOver{}
+	underOverAgainst := map[string]model.GoalsUnderOver{}
+	lineups := map[string]int{}
+
+	var form strings.Builder
+	var streak, longest struct{ wins, draws, loses int }
+	var biggestWinHome, biggestWinAway, biggestLoseHome, biggestLoseAway string
+	winMarginHome, winMarginAway, loseMarginHome, loseMarginAway := 0, 0, 0, 0
+	penaltyScored, penaltyMissed := 0, 0
+
+	for _, f := range season.Fixtures {
+		if !f.involves(team.ID) {
+			continue
+		}
+
+		home := f.Home.ID == team.ID
+		scored, conceded := f.goalsFor(team.ID)
+		result := f.result(team.ID)
+		score := fmt.Sprintf("%d-%d", f.HomeGoals, f.AwayGoals)
+		form.WriteString(result)
+
+		addRecord(&stats.Fixtures.Played, home, 1)
+		addRecord((*model.FixturesRecordDTO)(&stats.Goals.For.Total), home, scored)
+		addRecord((*model.FixturesRecordDTO)(&stats.Goals.Against.Total), home, conceded)
+
+		if conceded == 0 {
+			addRecord((*model.FixturesRecordDTO)(&stats.CleanSheet), home, 1)
+		}
+		if scored == 0 {
+			addRecord((*model.FixturesRecordDTO)(&stats.FailedToScore), home, 1)
+		}
+
+		switch result {
+		case "W":
+			addRecord(&stats.Fixtures.Wins, home, 1)
+			streak.wins, streak.draws, streak.loses = streak.wins + 1, 0, 0
+			margin := scored - conceded
+			if home && margin > winMarginHome {
+				winMarginHome, biggestWinHome = margin, score
+			}
+			if !home && margin > winMarginAway {
+				winMarginAway, biggestWinAway = margin, score
+			}
+		case "D":
+			addRecord(&stats.Fixtures.Draws, home, 1)
+			streak.wins, streak.draws, streak.loses = 0, streak.draws + 1, 0
+		default:
+			addRecord(&stats.Fixtures.Loses, home, 1)
+			streak.wins, streak.draws, streak.loses = 0, 0, streak.loses + 1
+			margin := conceded - scored
+			if home && margin > loseMarginHome {
+				loseMarginHome, biggestLoseHome = margin, score
+			}
+			if !home && margin > loseMarginAway {
+				loseMarginAway, biggestLoseAway = margin, score
+			}
+		}
+		longest.wins = max(longest.wins, streak.wins)
+		longest.draws = max(longest.draws, streak.draws)
+		longest.loses = max(longest.loses, streak.loses)
+
+		if home {
+			stats.Biggest.Goals.For.Home = max(stats.Biggest.Goals.For.Home, scored)
+			stats.Biggest.Goals.Against.Home = max(stats.Biggest.Goals.Against.Home, conceded)
+		} else {
+			stats.Biggest.Goals.For.Away = max(stats.Biggest.Goals.For.Away, scored)
+			stats.Biggest.Goals.Against.Away = max(stats.Biggest.Goals.Against.Away, conceded)
+		}
+
+		for _, g := range f.Goals {
+			bucket := minuteBucket(g.Minute)
+			if g.TeamID == team.ID {
+				goalsFor[bucket]++
+				if g.Penalty {
+					penaltyScored++
+				}
+			} else {
+				goalsAgainst[bucket]++
+			}
+		}
+
+		for _, c := range f.Cards {
+			if c.TeamID != team.ID {
+				continue
+			}
+			if c.Red {
+				red[minuteBucket(c.Minute)]++
+			} else {
+				yellow[minuteBucket(c.Minute)]++
+			}
+		}
+
+		if f.ID % 7 == 0 {
+			penaltyMissed++
+		}
+
+		for threshold := 0; threshold <= 4; threshold++ {
+			line := fmt.Sprintf("%d.5", threshold)
+			underOverFor[line] = tallyUnderOver(underOverFor[line], scored > threshold)
+			underOverAgainst[line] = tallyUnderOver(underOverAgainst[line], conceded > threshold)
+		}
+
+		lineups[f.formation(team)]++
+	}
+
+	stats.Form = form.String()
+
+	played := stats.Fixtures.Played
+	stats.Goals.For = goalsSide(stats.Goals.For.Total, played, goalsFor, underOverFor)
+	stats.Goals.Against = goalsSide(stats.Goals.Against.Total, played, goalsAgainst, underOverAgainst)
+
+	stats.Biggest.Streak = model.BiggestStreak{Wins: longest.wins, Draws: longest.draws, Loses: longest.loses}
+	stats.Biggest.Wins = model.BiggestResult{Home: biggestWinHome, Away: biggestWinAway}
+	stats.Biggest.Loses = model.BiggestResult{Home: biggestLoseHome, Away: biggestLoseAway}
+
+	penaltyTotal := penaltyScored + penaltyMissed
+	stats.Penalty = model.PenaltyDTO{
+		Scored: 	model.PenaltyDetail{Total: penaltyScored, Percentage: percentage(penaltyScored, penaltyTotal)},
+		Missed: 	model.PenaltyDetail{Total: penaltyMissed, Percentage: percentage(penaltyMissed, penaltyTotal)},
+		Total: 		penaltyTotal,
+	}
+
+	for formation, count := range lineups {
+		stats.Lineup = append(stats.Lineup, model.LineupDTO{Formation: formation, Played: count})
+	}
+	sort.Slice(stats.Lineup, func(i, j int) bool {
+		if stats.Lineup[i].Played != stats.Lineup[j].Played {
+			return stats.Lineup[i].Played > stats.Lineup[j].Played
+		}
+		return stats.Lineup[i].Formation < stats.Lineup[j].Formation
+	})
+
+	stats.Cards = model.CardsDTO{Yellow: cardDistribution(yellow), Red: cardDistribution(red)}
+
+	return stats
+}
+
+
+func addRecord(record *model.FixturesRecordDTO, home bool, value int) {
+	if home {
+		record.Home += value
+	} else {
+		record.Away += value
+	}
+	record.Total += value
+}
+
+
+func tallyUnderOver(uo model.GoalsUnderOver, over bool) model.GoalsUnderOver {
+	if over {
+		uo.Over++
+	} else {
+		uo.Under++
+	}
+	return uo
+}
+
+
+func goalsSide(total model.GoalsTotalStats, played model.FixturesRecordDTO, minutes map[string]int, underOver map[string]model.GoalsUnderOver) model.GoalsSide {
+	side := model.GoalsSide{
+		Total: 		total,
+		Average: 	model.GoalsAverageStats{
+			Home: 	average(total.Home, played.Home),
+			Away: 	average(total.Away, played.Away),
+			Total: 	average(total.Total, played.Total),
+		},
+		Minute: 	map[string]model.GoalsMinute{},
+		UnderOver: 	underOver,
+	}
+
+	for _, b := range minuteBuckets {
+		count, ok := minutes[b.Label]
+		if !ok {
+			side.Minute[b.Label] = model.GoalsMinute{}
+			continue
+		}
+		side.Minute[b.Label] = model.GoalsMinute{Total: intPtr(count), Percentage: percentage(count, total.Total)}
+	}
+
+	return side
+}
+
+
+func cardDistribution(counts map[string]int) model.CardDistribution {
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+
+	stat := func(label string) model.MinuteCardStat {
+		count, ok := counts[label]
+		if !ok {
+			return model.MinuteCardStat{}
+		}
+		return model.MinuteCardStat{Total: intPtr(count), Percentage: percentage(count, total)}
+	}
+
+	return model.CardDistribution{
+		M0_15: 		stat("0-15"),
+		M16_30: 	stat("16-30"),
+		M31_45: 	stat("31-45"),
+		M46_60: 	stat("46-60"),
+		M61_75: 	stat("61-75"),
+		M76_90: 	stat("76-90"),
+		M91_105: 	stat("91-105"),
+		M106_120: 	stat("106-120"),
+	}
+}
+
+
+func playerInfo(p mockPlayer) model.PlayerInfoDTO {
+	names := strings.SplitN(p.Name, ". ", 2)
+	birth := fmt.Sprintf("%d-%02d-%02d", currentSeason - p.Age, 1 + p.ID % 12, 1 + p.ID % 28)
+	nationality := mockCountries[p.ID % (len(mockCountries) - 1)].Name
+	height := fmt.Sprintf("%d cm", 170 + p.ID % 25)
+	weight := fmt.Sprintf("%d kg", 65 + p.ID % 25)
+	age := p.Age
+
+	return model.PlayerInfoDTO{
+		ID: 			p.ID,
+		Name: 			p.Name,
+		Firstname: 		names[0],
+		Lastname: 		names[len(names) - 1],
+		Age: 			&age,
+		Birth: 			model.PlayerBirthDTO{Date: &birth, Country: &nationality},
+		Nationality: 	&nationality,
+		Height: 		&height,
+		Weight: 		&weight,
+	}
+}
+
+
+func playersResponse(seed uint64, season *mockSeason, team *mockTeam) []model.PlayerStatsDTO {
+	players := []model.PlayerStatsDTO{}
+
+	for _, t := range season.playerTotals(team) {
+		rng := rand.New(rand.NewPCG(seed, uint64(season.Year * 100000 + t.Player.ID)))
+
+		games := t.Appearances
+		shots := t.Goals * 3 + rng.IntN(games * 2 + 1)
+		passes := games * (20 + rng.IntN(35))
+		duels := games * (4 + rng.IntN(8))
+		dribbles := games * rng.IntN(4)
+		number := t.Player.Number
+
+		var rating *string
+		if games > 0 {
+			value := fmt.Sprintf("%.6f", 6.2 + rng.Float64() * 1.0 + float64(t.Goals + t.Assists) / float64(games))
+			rating = &value
+		}
+
+		players = append(players, model.PlayerStatsDTO{
+			Player: 	playerInfo(t.Player),
+			Statistics: []model.PlayerStatisticsDTO{{
+				Team: 		model.FixtureTeam{ID: team.ID, Name: team.Name},
+				League: 	model.FixtureLeague{ID: leagueID, Name: leagueName, Country: leagueCountry, Season: season.Year},
+				Games: 		model.PlayerGamesDTO{
+					Appearences: 	intPtr(t.Appearances),
+					Lineups: 		intPtr(t.Lineups),
+					Minutes: 		intPtr(t.Minutes),
+					Number: 		&number,
+					Position: 		t.Player.Position,
+					Rating: 		rating,
+					Captain: 		t.Player.Number == 8,
+				},
+				Substitutes: 	model.PlayerSubstitutesDTO{In: intPtr(t.SubstitutesIn), Out: intPtr(t.SubstitutesOut), Bench: intPtr(t.Bench)},
+				Shots: 			model.PlayerShotsDTO{Total: intPtr(shots), On: intPtr(t.Goals + shots / 3)},
+				Goals: 			model.PlayerGoalsDTO{Total: intPtr(t.Goals), Assists: intPtr(t.Assists)},
+				Passes: 		model.PlayerPassesDTO{Total: intPtr(passes), Key: intPtr(t.Assists + passes / 40), Accuracy: intPtr(70 + rng.IntN(22))},
+				Tackles: 		model.PlayerTacklesDTO{Total: intPtr(games * rng.IntN(3)), Blocks: intPtr(rng.IntN(games + 1)), Interceptions: intPtr(games * rng.IntN(2))},
+				Duels: 			model.PlayerDuelsDTO{Total: intPtr(duels), Won: intPtr(duels * (40 + rng.IntN(25)) / 100)},
+				Dribbles: 		model.PlayerDribblesDTO{Attempts: intPtr(dribbles), Success: intPtr(dribbles / 2)},
+				Fouls: 			model.PlayerFoulsDTO{Drawn: intPtr(games + rng.IntN(games + 1)), Committed: intPtr(games + rng.IntN(games + 1))},
+				Cards: 			model.PlayerCardsDTO{Yellow: intPtr(t.Yellow), YellowRed: intPtr(0), Red: intPtr(t.Red)},
+				Penalty: 		model.PlayerPenaltyDTO{Scored: intPtr(t.PenaltyScored), Missed: intPtr(0)},
+			}},
+		})
+	}
+
+	return players
+}
+
+
+var topPlayerMetrics = map[string]func(st model.PlayerStatisticsDTO) int{
+	"topscorers": 		func(st model.PlayerStatisticsDTO) int { return *st.Goals.Total },
+	"topassists": 		func(st model.PlayerStatisticsDTO) int { return *st.Goals.Assists },
+	"topyellowcards": 	func(st model.PlayerStatisticsDTO) int { return *st.Cards.Yellow },
+	"topredcards": 		func(st model.PlayerStatisticsDTO) int { return *st.Cards.Red },
+}
+
+
+func topPlayersResponse(seed uint64, season *mockSeason, metric func(st model.PlayerStatisticsDTO) int) []model.PlayerStatsDTO {
+	var players []model.PlayerStatsDTO
+	for i := range mockTeams {
+		for _, p := range playersResponse(seed, season, &mockTeams[i]) {
+			if metric(p.Statistics[0]) > 0 {
+				players = append(players, p)
+			}
+		}
+	}
+
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := players[i].Statistics[0], players[j].Statistics[0]
+		if metric(a) != metric(b) {
+			return metric(a) > metric(b)
+		}
+		return *a.Games.Minutes < *b.Games.Minutes
+	})
+
+	if len(players) > topPlayersSize {
+		players = players[:topPlayersSize]
+	}
+	if players == nil {
+		return []model.PlayerStatsDTO{}
+	}
+
+	return players
+}
@@ -0,0 +1,427 @@
+package mock_provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+
+const dailyRequestLimit = 7500
+
+
+type Server struct {
+	seed		uint64
+	mux			*http.ServeMux
+	mu			sync.Mutex
+	seasons		map[int]*mockSeason
+	requests	int
+}
+
+
+func NewServer(seed uint64) *Server {
+	s := &Server{
+		seed: 		seed,
+		mux: 		http.NewServeMux(),
+		seasons: 	make(map[int]*mockSeason),
+	}
+
+	s.mux.HandleFunc("GET /countries", 			s.countries)
+	s.mux.HandleFunc("GET /leagues", 			s.leagues)
+	s.mux.HandleFunc("GET /teams", 				s.teams)
+	s.mux.HandleFunc("GET /teams/statistics", 	s.teamStatistics)
+	s.mux.HandleFunc("GET /venues", 			s.venues)
+	s.mux.HandleFunc("GET /standings", 			s.standings)
+	s.mux.HandleFunc("GET /fixtures", 			s.fixtures)
+	s.mux.HandleFunc("GET /fixtures/headtohead", s.headToHead)
+	s.mux.HandleFunc("GET /fixtures/events", 	s.fixtureEvents)
+	s.mux.HandleFunc("GET /fixtures/lineups", 	s.fixtureLineups)
+	s.mux.HandleFunc("GET /fixtures/statistics", s.fixtureStatistics)
+	s.mux.HandleFunc("GET /injuries", 			s.injuries)
+	s.mux.HandleFunc("GET /players/squads", 	s.squads)
+	s.mux.HandleFunc("GET /players", 			s.players)
+	s.mux.HandleFunc("GET /players/{leaderboard}", s.topPlayers)
+	s.mux.HandleFunc("GET /transfers", 			s.transfers)
+	s.mux.HandleFunc("GET /coachs", 			s.coachs)
+	s.mux.HandleFunc("GET /trophies", 			s.trophies)
+	s.mux.HandleFunc("GET /sidelined", 			s.sidelined)
+
+	return s
+}
+
+
+// ServeHTTP normalises the path first because the football client joins the
+// base url and endpoint with an extra slash.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.URL.Path = path.Clean("/" + strings.TrimLeft(r.URL.Path, "/"))
+
+	s.mu.Lock()
+	s.requests++
+	remaining := max(dailyRequestLimit - s.requests, 0)
+	s.mu.Unlock()
+
+	w.Header().Set("x-ratelimit-requests-limit", strconv.Itoa(dailyRequestLimit))
+	w.Header().Set("x-ratelimit-requests-remaining", strconv.Itoa(remaining))
+	w.Header().Set("X-RateLimit-Limit", "300")
+	w.Header().Set("X-RateLimit-Remaining", "299")
+
+	s.mux.ServeHTTP(w, r)
+}
+
+
+func (s *Server) season(year int) *mockSeason {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	season, ok := s.seasons[year]
+	if !ok {
+		season = generateSeason(s.seed, year)
+		s.seasons[year] = season
+	}
+
+	return season
+}
+
+
+func writeEnvelope(w http.ResponseWriter, r *http.Request, response any, results int) {
+	writeBody(w, r, response, results, []string{}, 1, 1)
+}
+
+
+func writeParameterError(w http.ResponseWriter, r *http.Request, field string) {
+	errors := map[string]string{field: fmt.Sprintf("The %s field is required.", strings.ToUpper(field[:1]) + field[1:])}
+	writeBody(w, r, []any{}, 0, errors, 1, 1)
+}
+
+
+func writeBody(w http.ResponseWriter, r *http.Request, response any, results int, errors any, page, pages int) {
+	parameters := map[string]string{}
+	for k := range r.URL.Query() {
+		parameters[k] = r.URL.Query().Get(k)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"get": 			strings.TrimPrefix(r.URL.Path, "/"),
+		"parameters": 	parameters,
+		"errors": 		errors,
+		"results": 		results,
+		"paging": 		map[string]int{"current": page, "total": pages},
+		"response": 	response,
+	})
+}
+
+
+func queryInt(r *http.Request, key string) (int, bool) {
+	v, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+
+func (s *Server) countries(w http.ResponseWriter, r *http.Request) {
+	response := countriesResponse()
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) leagues(w http.ResponseWriter, r *http.Request) {
+	teamID, _ := queryInt(r, "team")
+	response := leaguesResponse(teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) teams(w http.ResponseWriter, r *http.Request) {
+	id, ok := queryInt(r, "id")
+	if !ok {
+		writeParameterError(w, r, "id")
+		return
+	}
+
+	response := teamsResponse(id)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) teamStatistics(w http.ResponseWriter, r *http.Request) {
+	season, seasonOk := queryInt(r, "season")
+	teamID, teamOk := queryInt(r, "team")
+	if !seasonOk || !teamOk {
+		writeParameterError(w, r, "team")
+		return
+	}
+
+	team := teamByID(teamID)
+	if team == nil {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	writeEnvelope(w, r, teamStatisticsResponse(s.season(season), team), 11)
+}
+
+
+func (s *Server) venues(w http.ResponseWriter, r *http.Request) {
+	response := venuesResponse(r.URL.Query().Get("country"))
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) standings(w http.ResponseWriter, r *http.Request) {
+	season, ok := queryInt(r, "season")
+	if !ok {
+		writeParameterError(w, r, "season")
+		return
+	}
+
+	teamID, _ := queryInt(r, "team")
+	response := standingsResponse(s.season(season), teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) fixtures(w http.ResponseWriter, r *http.Request) {
+	season, ok := queryInt(r, "season")
+	if !ok {
+		writeParameterError(w, r, "season")
+		return
+	}
+
+	teamID, _ := queryInt(r, "team")
+	response := fixturesResponse(s.season(season), teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) fixture(id int) *mockFixture {
+	season := s.season(id / 1000)
+	for _, f := range season.Fixtures {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+
+func (s *Server) fixtureEvents(w http.ResponseWriter, r *http.Request) {
+	id, ok := queryInt(r, "fixture")
+	if !ok {
+		writeParameterError(w, r, "fixture")
+		return
+	}
+
+	fixture := s.fixture(id)
+	if fixture == nil {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	response := fixtureEventsResponse(fixture)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) fixtureLineups(w http.ResponseWriter, r *http.Request) {
+	id, ok := queryInt(r, "fixture")
+	if !ok {
+		writeParameterError(w, r, "fixture")
+		return
+	}
+
+	fixture := s.fixture(id)
+	if fixture == nil {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	response := fixtureLineupsResponse(fixture)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) fixtureStatistics(w http.ResponseWriter, r *http.Request) {
+	id, ok := queryInt(r, "fixture")
+	if !ok {
+		writeParameterError(w, r, "fixture")
+		return
+	}
+
+	fixture := s.fixture(id)
+	if fixture == nil {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	response := fixtureStatisticsResponse(s.seed, fixture)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) injuries(w http.ResponseWriter, r *http.Request) {
+	season, seasonOk := queryInt(r, "season")
+	teamID, teamOk := queryInt(r, "team")
+	if !seasonOk || !teamOk {
+		writeParameterError(w, r, "team")
+		return
+	}
+
+	response := injuriesResponse(s.season(season), teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) squads(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := queryInt(r, "team")
+	if !ok {
+		writeParameterError(w, r, "team")
+		return
+	}
+
+	response := squadsResponse(teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) players(w http.ResponseWriter, r *http.Request) {
+	season, seasonOk := queryInt(r, "season")
+	teamID, teamOk := queryInt(r, "team")
+	if !seasonOk || !teamOk {
+		writeParameterError(w, r, "team")
+		return
+	}
+
+	page, ok := queryInt(r, "page")
+	if !ok {
+		page = 1
+	}
+
+	team := teamByID(teamID)
+	if team == nil {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	response := playersResponse(s.seed, s.season(season), team)
+	pages := (len(response) + playersPageSize - 1) / playersPageSize
+	if page < 1 || page > pages {
+		writeBody(w, r, []any{}, 0, map[string]string{"page": "Maximum page number is " + strconv.Itoa(pages)}, page, pages)
+		return
+	}
+
+	response = response[(page - 1) * playersPageSize:min(page * playersPageSize, len(response))]
+	writeBody(w, r, response, len(response), []string{}, page, pages)
+}
+
+
+func (s *Server) topPlayers(w http.ResponseWriter, r *http.Request) {
+	metric, ok := topPlayerMetrics[r.PathValue("leaderboard")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	season, seasonOk := queryInt(r, "season")
+	league, leagueOk := queryInt(r, "league")
+	if !seasonOk || !leagueOk {
+		writeParameterError(w, r, "league")
+		return
+	}
+
+	if league != leagueID {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	response := topPlayersResponse(s.seed, s.season(season), metric)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) transfers(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := queryInt(r, "team")
+	if !ok {
+		writeParameterError(w, r, "team")
+		return
+	}
+
+	response := transfersResponse(teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) coachs(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := queryInt(r, "team")
+	if !ok {
+		writeParameterError(w, r, "team")
+		return
+	}
+
+	response := coachsResponse(teamID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) trophies(w http.ResponseWriter, r *http.Request) {
+	var team *mockTeam
+	var from, to int
+
+	if playerID, ok := queryInt(r, "player"); ok {
+		if playerByID(playerID) != nil {
+			team = teamByID(playerID / 1000)
+			from, to = currentSeason - 5, currentSeason - 1
+		}
+	} else if coachID, ok := queryInt(r, "coach"); ok {
+		// coach ids are 9000 + team id for current coaches and 9100 + team id
+		// for former ones
+		team = teamByID(coachID % 100)
+		if team != nil {
+			from, to = coachChangeSeason, currentSeason - 1
+			if coachID == formerCoach(team).ID {
+				from, to = formerCoachSeason, coachChangeSeason - 1
+			}
+		}
+	} else {
+		writeParameterError(w, r, "player")
+		return
+	}
+
+	if team == nil {
+		writeEnvelope(w, r, []any{}, 0)
+		return
+	}
+
+	response := trophiesResponse(s.season, team, from, to)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) sidelined(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := queryInt(r, "player")
+	if !ok {
+		writeParameterError(w, r, "player")
+		return
+	}
+
+	response := sidelinedResponse(playerID)
+	writeEnvelope(w, r, response, len(response))
+}
+
+
+func (s *Server) headToHead(w http.ResponseWriter, r *http.Request) {
+	var teamID, opponentID int
+	if _, err := fmt.Sscanf(r.URL.Query().Get("h2h"), "%d-%d", &teamID, &opponentID); err != nil || teamID == opponentID {
+		writeParameterError(w, r, "h2h")
+		return
+	}
+
+	response := headToHeadResponse(s.season, teamID, opponentID)
+	writeEnvelope(w, r, response, len(response))
+}
@@ -0,0 +1,18 @@
+package model
+
+import "encoding/json"
+
+
+type APIPaging struct {
+	Current		int		`json:"current"`
+	Total		int		`json:"total"`
+}
+
+
+type APIEnvelope struct {
+	Get			string			`json:"get"`
+	Parameters	json.RawMessage	`json:"parameters"`
+	Errors		json.RawMessage	`json:"errors"`
+	Results		int				`json:"results"`
+	Paging		APIPaging		`json:"paging"`
+}
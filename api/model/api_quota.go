@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+
+type APIQuota struct {
+	ID					uint	`gorm:"primaryKey"`
+	DailyLimit			int
+	DailyRemaining		int
+	MinuteLimit			int
+	MinuteRemaining		int
+	DailyUpdatedAt		time.Time
+	UpdatedAt			time.Time
+}
+
+
+type APIQuotaDTO struct {
+	DailyLimit			int			`json:"daily_limit"`
+	DailyRemaining		int			`json:"daily_remaining"`
+	MinuteLimit			int			`json:"minute_limit"`
+	MinuteRemaining		int			`json:"minute_remaining"`
+	DailyUpdatedAt		time.Time	`json:"daily_updated_at"`
+	UpdatedAt			time.Time	`json:"updated_at"`
+}
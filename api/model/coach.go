@@ -0,0 +1,110 @@
+package model
+
+type Coach struct {
+	ID				uint	`gorm:"primaryKey"`
+
+	CoachID			int		`gorm:"uniqueIndex"`
+	Name			string
+	Firstname		string
+	Lastname		string
+	Age				int
+	BirthDate		string
+	BirthPlace		string
+	BirthCountry	string
+	Nationality		string
+	Photo			string
+
+	TeamID			int
+	TeamName		string
+}
+
+
+type CoachCareer struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	CoachID		int		`gorm:"uniqueIndex:idx_coach_career"`
+	TeamID		int		`gorm:"uniqueIndex:idx_coach_career"`
+	TeamName	string
+	TeamLogo	string
+	Start		string	`gorm:"uniqueIndex:idx_coach_career"`
+	End			string
+}
+
+
+type CoachCareerDTO struct {
+	Team	FixtureTeam	`json:"team"`
+	Start	string		`json:"start"`
+	End		*string		`json:"end"`
+}
+
+
+type CoachDTO struct {
+	ID			int					`json:"id"`
+	Name		string				`json:"name"`
+	Firstname	string				`json:"firstname"`
+	Lastname	string				`json:"lastname"`
+	Age			*int				`json:"age"`
+	Birth		PlayerBirthDTO		`json:"birth"`
+	Nationality	*string				`json:"nationality"`
+	Height		*string				`json:"height"`
+	Weight		*string				`json:"weight"`
+	Photo		string				`json:"photo"`
+	Team		FixtureTeam			`json:"team"`
+	Career		[]CoachCareerDTO	`json:"career"`
+}
+
+
+type CoachResponse struct {
+	Response []CoachDTO `json:"response"`
+}
+
+
+type ManchesterUnitedTenureRecordDTO struct {
+	Played			int		`json:"played"`
+	Wins			int		`json:"wins"`
+	Draws			int		`json:"draws"`
+	Losses			int		`json:"losses"`
+	GoalsFor		int		`json:"goals_for"`
+	GoalsAgainst	int		`json:"goals_against"`
+	GoalDifference	int		`json:"goal_difference"`
+	PointsPerGame	float64	`json:"points_per_game"`
+	WinPercentage	float64	`json:"win_percentage"`
+}
+
+
+type ManchesterUnitedCoachDTO struct {
+	CoachID		int								`json:"coach_id"`
+	Name		string							`json:"name"`
+	Nationality	string							`json:"nationality"`
+	Start		string							`json:"start"`
+	End			string							`json:"end"`
+	Current		bool							`json:"current"`
+	Record		ManchesterUnitedTenureRecordDTO	`json:"record"`
+}
+
+
+type ManchesterUnitedCoachCareerDTO struct {
+	TeamName	string	`json:"team_name"`
+	Start		string	`json:"start"`
+	End			string	`json:"end"`
+}
+
+
+type ManchesterUnitedTenureSeasonDTO struct {
+	Season	int								`json:"season"`
+	Record	ManchesterUnitedTenureRecordDTO	`json:"record"`
+}
+
+
+type ManchesterUnitedCoachProfileDTO struct {
+	CoachID		int									`json:"coach_id"`
+	Name		string								`json:"name"`
+	Firstname	string								`json:"firstname"`
+	Lastname	string								`json:"lastname"`
+	Age			int									`json:"age"`
+	BirthDate	string								`json:"birth_date"`
+	Nationality	string								`json:"nationality"`
+	Career		[]ManchesterUnitedCoachCareerDTO	`json:"career"`
+	Record		ManchesterUnitedTenureRecordDTO		`json:"record"`
+	Seasons		[]ManchesterUnitedTenureSeasonDTO	`json:"seasons"`
+}
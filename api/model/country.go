@@ -3,7 +3,7 @@ package model
 
 type Country struct {
 	ID		uint	`gorm:"primaryKey"`
-	Name	string
+	Name	string	`gorm:"uniqueIndex"`
 	Code	string
 }
 
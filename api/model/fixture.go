@@ -1,9 +1,12 @@
 package model
 
+import "time"
+
+
 type Fixture struct {
 	ID        uint   `gorm:"primaryKey"`
 
-	FixtureID  int
+	FixtureID  int	`gorm:"uniqueIndex"`
 	Referee    string
 	Timezone   string
 	Date       string
@@ -139,6 +142,7 @@ type FixtureResponse struct {
 
 
 type ManchesterUnitedFixturesDTO struct {
+	FixtureID			int		`json:"fixture_id"`
 	Referee    			string	`json:"referee"`
 	Date       			string	`json:"date"`
 	VenueName 			string	`json:"venue_name"`
@@ -165,4 +169,24 @@ type ManchesterUnitedFixturesDTO struct {
 	ExtratimeAway 		int		`json:"extra_time_away"`
 	PenaltyHome   		int		`json:"penalty_home"`
 	PenaltyAway   		int		`json:"penalty_away"`
+}
+
+
+const (
+	FixtureVenueHome	= "home"
+	FixtureVenueAway	= "away"
+)
+
+
+// FixtureFilter narrows the tracked team's fixtures. Zero values leave a field
+// unfiltered, From and To are inclusive days.
+type FixtureFilter struct {
+	Season		int
+	CoachID		int
+	OpponentID	int
+	Venue		string
+	Result		string
+	Round		string
+	From		time.Time
+	To			time.Time
 }
\ No newline at end of file
@@ -0,0 +1,64 @@
+package model
+
+type FixtureEvent struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	FixtureID	int		`gorm:"uniqueIndex:idx_fixture_event_sequence"`
+	Sequence	int		`gorm:"uniqueIndex:idx_fixture_event_sequence"`
+
+	Elapsed		int
+	Extra		*int
+
+	TeamID		int
+	TeamName	string
+	TeamLogo	string
+
+	PlayerID	*int
+	PlayerName	string
+	AssistID	*int
+	AssistName	string
+
+	Type		string
+	Detail		string
+	Comments	string
+}
+
+
+type FixtureEventTime struct {
+	Elapsed	int		`json:"elapsed"`
+	Extra	*int	`json:"extra"`
+}
+
+
+type FixtureEventPlayer struct {
+	ID		*int	`json:"id"`
+	Name	*string	`json:"name"`
+}
+
+
+type FixtureEventDTO struct {
+	Time		FixtureEventTime	`json:"time"`
+	Team		FixtureTeam			`json:"team"`
+	Player		FixtureEventPlayer	`json:"player"`
+	Assist		FixtureEventPlayer	`json:"assist"`
+	Type		string				`json:"type"`
+	Detail		string				`json:"detail"`
+	Comments	*string				`json:"comments"`
+}
+
+
+type FixtureEventResponse struct {
+	Response []FixtureEventDTO `json:"response"`
+}
+
+
+type ManchesterUnitedFixtureEventDTO struct {
+	Elapsed		int		`json:"elapsed"`
+	Extra		int		`json:"extra"`
+	TeamName	string	`json:"team_name"`
+	PlayerName	string	`json:"player_name"`
+	AssistName	string	`json:"assist_name"`
+	Type		string	`json:"type"`
+	Detail		string	`json:"detail"`
+	Comments	string	`json:"comments"`
+}
@@ -0,0 +1,107 @@
+package model
+
+type FixtureLineup struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	FixtureID	int		`gorm:"uniqueIndex:idx_fixture_lineup_team"`
+	TeamID		int		`gorm:"uniqueIndex:idx_fixture_lineup_team"`
+	TeamName	string
+	TeamLogo	string
+
+	Formation	string
+	CoachID		*int
+	CoachName	string
+}
+
+
+type FixtureLineupPlayer struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	FixtureID	int		`gorm:"uniqueIndex:idx_fixture_lineup_player"`
+	TeamID		int
+	PlayerID	int		`gorm:"uniqueIndex:idx_fixture_lineup_player"`
+	PlayerName	string
+	Number		int
+	Position	string
+	Grid		string
+	Starter		bool
+}
+
+
+type FixtureLineupCoach struct {
+	ID		*int	`json:"id"`
+	Name	*string	`json:"name"`
+	Photo	*string	`json:"photo"`
+}
+
+
+type FixtureLineupPlayerInfo struct {
+	ID		int		`json:"id"`
+	Name	string	`json:"name"`
+	Number	int		`json:"number"`
+	Pos		string	`json:"pos"`
+	Grid	*string	`json:"grid"`
+}
+
+
+type FixtureLineupEntry struct {
+	Player FixtureLineupPlayerInfo `json:"player"`
+}
+
+
+type FixtureLineupDTO struct {
+	Team		FixtureTeam				`json:"team"`
+	Coach		FixtureLineupCoach		`json:"coach"`
+	Formation	string					`json:"formation"`
+	StartXI		[]FixtureLineupEntry	`json:"startXI"`
+	Substitutes	[]FixtureLineupEntry	`json:"substitutes"`
+}
+
+
+type FixtureLineupResponse struct {
+	Response []FixtureLineupDTO `json:"response"`
+}
+
+
+type ManchesterUnitedLineupPlayerDTO struct {
+	PlayerID	int		`json:"player_id"`
+	PlayerName	string	`json:"player_name"`
+	Number		int		`json:"number"`
+	Position	string	`json:"position"`
+	Grid		string	`json:"grid"`
+}
+
+
+type ManchesterUnitedFixtureLineupDTO struct {
+	TeamName	string								`json:"team_name"`
+	Formation	string								`json:"formation"`
+	CoachName	string								`json:"coach_name"`
+	StartXI		[]ManchesterUnitedLineupPlayerDTO	`json:"start_xi"`
+	Substitutes	[]ManchesterUnitedLineupPlayerDTO	`json:"substitutes"`
+}
+
+
+type ManchesterUnitedAppearanceDTO struct {
+	FixtureID		int		`json:"fixture_id"`
+	Date			string	`json:"date"`
+	Season			int		`json:"season"`
+	Round			string	`json:"round"`
+	HomeTeamName	string	`json:"home_team_name"`
+	AwayTeamName	string	`json:"away_team_name"`
+	GoalsHome		int		`json:"goals_home"`
+	GoalsAway		int		`json:"goals_away"`
+	Starter			bool	`json:"starter"`
+	SubbedOn		bool	`json:"subbed_on"`
+	Position		string	`json:"position"`
+	Number			int		`json:"number"`
+}
+
+
+type ManchesterUnitedAppearancesDTO struct {
+	PlayerID		int								`json:"player_id"`
+	PlayerName		string							`json:"player_name"`
+	Appearances		int								`json:"appearances"`
+	Starts			int								`json:"starts"`
+	UnusedSubs		int								`json:"unused_substitute"`
+	Fixtures		[]ManchesterUnitedAppearanceDTO	`json:"fixtures"`
+}
@@ -0,0 +1,87 @@
+package model
+
+type FixtureStatistics struct {
+	ID				uint	`gorm:"primaryKey"`
+
+	FixtureID		int		`gorm:"uniqueIndex:idx_fixture_statistics_team"`
+	TeamID			int		`gorm:"uniqueIndex:idx_fixture_statistics_team"`
+	TeamName		string
+
+	ShotsOnGoal		int
+	ShotsOffGoal	int
+	TotalShots		int
+	BlockedShots	int
+	ShotsInsideBox	int
+	ShotsOutsideBox	int
+	Fouls			int
+	CornerKicks		int
+	Offsides		int
+	BallPossession	int
+	YellowCards		int
+	RedCards		int
+	GoalkeeperSaves	int
+	TotalPasses		int
+	PassesAccurate	int
+	PassesPct		int
+	ExpectedGoals	*float64
+}
+
+
+type FixtureStatisticDTO struct {
+	Type	string	`json:"type"`
+	Value	any		`json:"value"`
+}
+
+
+type FixtureStatisticsDTO struct {
+	Team		FixtureTeam				`json:"team"`
+	Statistics	[]FixtureStatisticDTO	`json:"statistics"`
+}
+
+
+type FixtureStatisticsResponse struct {
+	Response []FixtureStatisticsDTO `json:"response"`
+}
+
+
+type ManchesterUnitedFixtureStatisticsDTO struct {
+	TeamName		string		`json:"team_name"`
+	ShotsOnGoal		int			`json:"shots_on_goal"`
+	ShotsOffGoal	int			`json:"shots_off_goal"`
+	TotalShots		int			`json:"total_shots"`
+	BlockedShots	int			`json:"blocked_shots"`
+	ShotsInsideBox	int			`json:"shots_inside_box"`
+	ShotsOutsideBox	int			`json:"shots_outside_box"`
+	Fouls			int			`json:"fouls"`
+	CornerKicks		int			`json:"corner_kicks"`
+	Offsides		int			`json:"offsides"`
+	BallPossession	int			`json:"ball_possession"`
+	YellowCards		int			`json:"yellow_cards"`
+	RedCards		int			`json:"red_cards"`
+	GoalkeeperSaves	int			`json:"goalkeeper_saves"`
+	TotalPasses		int			`json:"total_passes"`
+	PassesAccurate	int			`json:"passes_accurate"`
+	PassesPct		int			`json:"passes_percentage"`
+	ExpectedGoals	*float64	`json:"expected_goals"`
+}
+
+
+type ManchesterUnitedMatchStatsAveragesDTO struct {
+	Matches					int		`json:"matches"`
+	BallPossession			float64	`json:"ball_possession"`
+	ShotsPerGame			float64	`json:"shots_per_game"`
+	ShotsOnTargetPerGame	float64	`json:"shots_on_target_per_game"`
+	CornersPerGame			float64	`json:"corners_per_game"`
+	FoulsPerGame			float64	`json:"fouls_per_game"`
+	PassesPerGame			float64	`json:"passes_per_game"`
+	PassAccuracy			float64	`json:"pass_accuracy"`
+	ExpectedGoalsPerGame	float64	`json:"expected_goals_per_game"`
+}
+
+
+type ManchesterUnitedSeasonMatchStatsDTO struct {
+	Season	int										`json:"season"`
+	Home	ManchesterUnitedMatchStatsAveragesDTO	`json:"home"`
+	Away	ManchesterUnitedMatchStatsAveragesDTO	`json:"away"`
+	Total	ManchesterUnitedMatchStatsAveragesDTO	`json:"total"`
+}
@@ -0,0 +1,35 @@
+package model
+
+// HeadToHeadMeeting is a meeting stored by fetch-h2h. Cup ties and seasons
+// outside the tracked league live here, apart from the league fixtures.
+type HeadToHeadMeeting Fixture
+
+
+func (HeadToHeadMeeting) TableName() string {
+	return "head_to_head_meetings"
+}
+
+
+type ManchesterUnitedMeetingDTO struct {
+	FixtureID		int		`json:"fixture_id"`
+	Date			string	`json:"date"`
+	Season			int		`json:"season"`
+	LeagueName		string	`json:"league_name"`
+	HomeTeamName	string	`json:"home_team_name"`
+	AwayTeamName	string	`json:"away_team_name"`
+	GoalsHome		int		`json:"goals_home"`
+	GoalsAway		int		`json:"goals_away"`
+	Result			string	`json:"result"`
+}
+
+
+type ManchesterUnitedHeadToHeadDTO struct {
+	TeamName		string							`json:"team_name"`
+	OpponentName	string							`json:"opponent_name"`
+	Total			ManchesterUnitedTenureRecordDTO	`json:"total"`
+	Home			ManchesterUnitedTenureRecordDTO	`json:"home"`
+	Away			ManchesterUnitedTenureRecordDTO	`json:"away"`
+	LastMeetings	[]ManchesterUnitedMeetingDTO	`json:"last_meetings"`
+	BiggestWin		*ManchesterUnitedMeetingDTO		`json:"biggest_win"`
+	BiggestLoss		*ManchesterUnitedMeetingDTO		`json:"biggest_loss"`
+}
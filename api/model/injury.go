@@ -3,7 +3,7 @@ package model
 type Injury struct {
 	ID uint `gorm:"primaryKey"`
 
-	PlayerID   	int
+	PlayerID   	int	`gorm:"uniqueIndex:idx_injury_player_fixture"`
 	PlayerName 	string
 	PlayerPhoto string
 	Type       	string
@@ -13,7 +13,7 @@ type Injury struct {
 	TeamName string
 	TeamLogo string
 
-	FixtureID  			int
+	FixtureID  			int	`gorm:"uniqueIndex:idx_injury_player_fixture"`
 	FixtureDate 		string
 	FixtureTimestamp 	int64
 	FixtureTimezone 	string
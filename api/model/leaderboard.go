@@ -0,0 +1,56 @@
+package model
+
+const (
+	LeaderboardScorers		= "scorers"
+	LeaderboardAssists		= "assists"
+	LeaderboardYellowCards	= "yellowcards"
+	LeaderboardRedCards		= "redcards"
+)
+
+
+var LeaderboardMetrics = []string{LeaderboardScorers, LeaderboardAssists, LeaderboardYellowCards, LeaderboardRedCards}
+
+
+type LeaderboardEntry struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	LeagueID	int		`gorm:"uniqueIndex:idx_leaderboard_rank"`
+	Season		int		`gorm:"uniqueIndex:idx_leaderboard_rank"`
+	Metric		string	`gorm:"uniqueIndex:idx_leaderboard_rank"`
+	Rank		int		`gorm:"uniqueIndex:idx_leaderboard_rank"`
+
+	PlayerID	int
+	PlayerName	string
+	Nationality	string
+	TeamID		int
+	TeamName	string
+
+	Value		int
+	Appearances	int
+	Minutes		int
+	Goals		int
+	Assists		int
+	YellowCards	int
+	RedCards	int
+}
+
+
+type ManchesterUnitedLeaderboardEntryDTO struct {
+	Rank			int		`json:"rank"`
+	PlayerID		int		`json:"player_id"`
+	PlayerName		string	`json:"player_name"`
+	TeamName		string	`json:"team_name"`
+	Value			int		`json:"value"`
+	Appearances		int		`json:"appearances"`
+	Minutes			int		`json:"minutes"`
+	Highlighted		bool	`json:"highlighted"`
+}
+
+
+type ManchesterUnitedLeaderboardDTO struct {
+	LeagueID	int										`json:"league_id"`
+	Season		int										`json:"season"`
+	Metric		string									`json:"metric"`
+	Entries		[]ManchesterUnitedLeaderboardEntryDTO	`json:"entries"`
+	TeamEntries	[]ManchesterUnitedLeaderboardEntryDTO	`json:"team_entries"`
+}
@@ -3,16 +3,16 @@ package model
 
 type League struct {
 	ID			uint	`gorm:"primaryKey"`
-	LeagueID	int
+	LeagueID	int		`gorm:"uniqueIndex:idx_league_year_team"`
 	Name		string
 	Type		string
 	Country		string
 	CountryCode	string
-	Year		int		
+	Year		int		`gorm:"uniqueIndex:idx_league_year_team"`
 	Start		string	
 	End			string	
 	Current		bool
-	TeamID     	int	
+	TeamID     	int		`gorm:"uniqueIndex:idx_league_year_team"`
 }
 
 
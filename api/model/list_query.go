@@ -0,0 +1,26 @@
+package model
+
+// ListQuery holds the ?limit=, ?offset=, ?sort= and field filter parameters of a
+// list endpoint. Sort names a field per entry, prefixed with "-" for descending
+// order, and every filter holds the values it may equal.
+type ListQuery struct {
+	Limit	int
+	Offset	int
+	Sort	[]string
+	Filters	map[string][]string
+}
+
+
+type PaginationDTO struct {
+	Total		int64	`json:"total"`
+	Limit		int		`json:"limit"`
+	Offset		int		`json:"offset"`
+	NextOffset	*int	`json:"next_offset"`
+}
+
+
+type ManchesterUnitedPageDTO[T any] struct {
+	Results		int				`json:"results"`
+	Pagination	PaginationDTO	`json:"pagination"`
+	Response	[]T				`json:"response"`
+}
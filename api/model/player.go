@@ -0,0 +1,269 @@
+package model
+
+type Player struct {
+	ID				uint	`gorm:"primaryKey"`
+
+	PlayerID		int		`gorm:"uniqueIndex"`
+	Name			string
+	Firstname		string
+	Lastname		string
+	Age				int
+	BirthDate		string
+	BirthPlace		string
+	BirthCountry	string
+	Nationality		string
+	Height			string
+	Weight			string
+	Photo			string
+}
+
+
+type PlayerSeasonStats struct {
+	ID					uint	`gorm:"primaryKey"`
+
+	PlayerID			int		`gorm:"uniqueIndex:idx_player_season_stats"`
+	PlayerName			string
+	TeamID				int		`gorm:"uniqueIndex:idx_player_season_stats"`
+	TeamName			string
+	LeagueID			int		`gorm:"uniqueIndex:idx_player_season_stats"`
+	LeagueName			string
+	Season				int		`gorm:"uniqueIndex:idx_player_season_stats"`
+
+	Appearances			int
+	Lineups				int
+	Minutes				int
+	Number				*int
+	Position			string
+	Rating				*float64
+	Captain				bool
+	SubstitutesIn		int
+	SubstitutesOut		int
+	Bench				int
+
+	ShotsTotal			int
+	ShotsOn				int
+	Goals				int
+	GoalsConceded		int
+	Assists				int
+	Saves				int
+
+	PassesTotal			int
+	PassesKey			int
+	PassesAccuracy		int
+
+	Tackles				int
+	Blocks				int
+	Interceptions		int
+	DuelsTotal			int
+	DuelsWon			int
+	DribblesAttempts	int
+	DribblesSuccess		int
+	FoulsDrawn			int
+	FoulsCommitted		int
+
+	YellowCards			int
+	YellowRedCards		int
+	RedCards			int
+
+	PenaltyWon			int
+	PenaltyCommitted	int
+	PenaltyScored		int
+	PenaltyMissed		int
+	PenaltySaved		int
+}
+
+
+type PlayerBirthDTO struct {
+	Date	*string	`json:"date"`
+	Place	*string	`json:"place"`
+	Country	*string	`json:"country"`
+}
+
+
+type PlayerInfoDTO struct {
+	ID			int				`json:"id"`
+	Name		string			`json:"name"`
+	Firstname	string			`json:"firstname"`
+	Lastname	string			`json:"lastname"`
+	Age			*int			`json:"age"`
+	Birth		PlayerBirthDTO	`json:"birth"`
+	Nationality	*string			`json:"nationality"`
+	Height		*string			`json:"height"`
+	Weight		*string			`json:"weight"`
+	Injured		bool			`json:"injured"`
+	Photo		string			`json:"photo"`
+}
+
+
+type PlayerGamesDTO struct {
+	Appearences	*int	`json:"appearences"`
+	Lineups		*int	`json:"lineups"`
+	Minutes		*int	`json:"minutes"`
+	Number		*int	`json:"number"`
+	Position	string	`json:"position"`
+	Rating		*string	`json:"rating"`
+	Captain		bool	`json:"captain"`
+}
+
+
+type PlayerSubstitutesDTO struct {
+	In		*int	`json:"in"`
+	Out		*int	`json:"out"`
+	Bench	*int	`json:"bench"`
+}
+
+
+type PlayerShotsDTO struct {
+	Total	*int	`json:"total"`
+	On		*int	`json:"on"`
+}
+
+
+type PlayerGoalsDTO struct {
+	Total		*int	`json:"total"`
+	Conceded	*int	`json:"conceded"`
+	Assists		*int	`json:"assists"`
+	Saves		*int	`json:"saves"`
+}
+
+
+type PlayerPassesDTO struct {
+	Total		*int	`json:"total"`
+	Key			*int	`json:"key"`
+	Accuracy	*int	`json:"accuracy"`
+}
+
+
+type PlayerTacklesDTO struct {
+	Total			*int	`json:"total"`
+	Blocks			*int	`json:"blocks"`
+	Interceptions	*int	`json:"interceptions"`
+}
+
+
+type PlayerDuelsDTO struct {
+	Total	*int	`json:"total"`
+	Won		*int	`json:"won"`
+}
+
+
+type PlayerDribblesDTO struct {
+	Attempts	*int	`json:"attempts"`
+	Success		*int	`json:"success"`
+	Past		*int	`json:"past"`
+}
+
+
+type PlayerFoulsDTO struct {
+	Drawn		*int	`json:"drawn"`
+	Committed	*int	`json:"committed"`
+}
+
+
+type PlayerCardsDTO struct {
+	Yellow		*int	`json:"yellow"`
+	YellowRed	*int	`json:"yellowred"`
+	Red			*int	`json:"red"`
+}
+
+
+type PlayerPenaltyDTO struct {
+	Won			*int	`json:"won"`
+	Commited	*int	`json:"commited"`
+	Scored		*int	`json:"scored"`
+	Missed		*int	`json:"missed"`
+	Saved		*int	`json:"saved"`
+}
+
+
+type PlayerStatisticsDTO struct {
+	Team		FixtureTeam				`json:"team"`
+	League		FixtureLeague			`json:"league"`
+	Games		PlayerGamesDTO			`json:"games"`
+	Substitutes	PlayerSubstitutesDTO	`json:"substitutes"`
+	Shots		PlayerShotsDTO			`json:"shots"`
+	Goals		PlayerGoalsDTO			`json:"goals"`
+	Passes		PlayerPassesDTO			`json:"passes"`
+	Tackles		PlayerTacklesDTO		`json:"tackles"`
+	Duels		PlayerDuelsDTO			`json:"duels"`
+	Dribbles	PlayerDribblesDTO		`json:"dribbles"`
+	Fouls		PlayerFoulsDTO			`json:"fouls"`
+	Cards		PlayerCardsDTO			`json:"cards"`
+	Penalty		PlayerPenaltyDTO		`json:"penalty"`
+}
+
+
+type PlayerStatsDTO struct {
+	Player		PlayerInfoDTO			`json:"player"`
+	Statistics	[]PlayerStatisticsDTO	`json:"statistics"`
+}
+
+
+type PlayerStatsResponse struct {
+	Paging		APIPaging			`json:"paging"`
+	Response	[]PlayerStatsDTO	`json:"response"`
+}
+
+
+type ManchesterUnitedPlayerDTO struct {
+	PlayerID	int		`json:"player_id"`
+	Name		string	`json:"name"`
+	Age			int		`json:"age"`
+	Nationality	string	`json:"nationality"`
+	Position	string	`json:"position"`
+}
+
+
+type ManchesterUnitedPlayerSeasonDTO struct {
+	Season		int		`json:"season"`
+	TeamName	string	`json:"team_name"`
+	LeagueName	string	`json:"league_name"`
+	Appearances	int		`json:"appearances"`
+	Goals		int		`json:"goals"`
+	Assists		int		`json:"assists"`
+}
+
+
+type ManchesterUnitedPlayerProfileDTO struct {
+	PlayerID		int									`json:"player_id"`
+	Name			string								`json:"name"`
+	Firstname		string								`json:"firstname"`
+	Lastname		string								`json:"lastname"`
+	Age				int									`json:"age"`
+	BirthDate		string								`json:"birth_date"`
+	BirthPlace		string								`json:"birth_place"`
+	BirthCountry	string								`json:"birth_country"`
+	Nationality		string								`json:"nationality"`
+	Height			string								`json:"height"`
+	Weight			string								`json:"weight"`
+	Seasons			[]ManchesterUnitedPlayerSeasonDTO	`json:"seasons"`
+}
+
+
+type ManchesterUnitedPlayerStatsDTO struct {
+	TeamName			string		`json:"team_name"`
+	LeagueName			string		`json:"league_name"`
+	Season				int			`json:"season"`
+	Position			string		`json:"position"`
+	Appearances			int			`json:"appearances"`
+	Lineups				int			`json:"lineups"`
+	Minutes				int			`json:"minutes"`
+	Rating				*float64	`json:"rating"`
+	Goals				int			`json:"goals"`
+	Assists				int			`json:"assists"`
+	ShotsTotal			int			`json:"shots_total"`
+	ShotsOn				int			`json:"shots_on"`
+	PassesTotal			int			`json:"passes_total"`
+	PassesKey			int			`json:"passes_key"`
+	PassesAccuracy		int			`json:"passes_accuracy"`
+	Tackles				int			`json:"tackles"`
+	Interceptions		int			`json:"interceptions"`
+	DuelsTotal			int			`json:"duels_total"`
+	DuelsWon			int			`json:"duels_won"`
+	DribblesAttempts	int			`json:"dribbles_attempts"`
+	DribblesSuccess		int			`json:"dribbles_success"`
+	YellowCards			int			`json:"yellow_cards"`
+	RedCards			int			`json:"red_cards"`
+	PenaltyScored		int			`json:"penalty_scored"`
+	PenaltyMissed		int			`json:"penalty_missed"`
+}
@@ -0,0 +1,24 @@
+package model
+
+type ManchesterUnitedInjuryCountDTO struct {
+	PlayerName		string	`json:"player_name"`
+	Reason			string	`json:"reason"`
+	FixturesMissed	int		`json:"fixtures_missed"`
+}
+
+
+type ManchesterUnitedSeasonSummaryDTO struct {
+	Season			int									`json:"season"`
+	Position		*ManchesterUnitedTablePositionDTO	`json:"position"`
+	Games			*ManchesterUnitedGamesDTO			`json:"games"`
+	Goals			*ManchesterUnitedGoalsDTO			`json:"goals"`
+	Streak			*ManchesterUnitedStreakDTO			`json:"streak"`
+	Biggest			*ManchesterUnitedBiggestDTO			`json:"biggest"`
+	CleanSheet		*ManchesterUnitedCleanSheetDTO		`json:"clean_sheet"`
+	FailedToScore	*ManchesterUnitedFailedScoringDTO	`json:"failed_to_score"`
+	Penalty			*ManchesterUnitedPenaltyDTO			`json:"penalty"`
+	Cards			*ManchesterUnitedCardsDTO			`json:"cards"`
+	Formations		*ManchesterUnitedLineupDTO			`json:"formations"`
+	Results			[]ManchesterUnitedMeetingDTO		`json:"results"`
+	TopInjuries		[]ManchesterUnitedInjuryCountDTO	`json:"top_injuries"`
+}
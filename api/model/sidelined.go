@@ -0,0 +1,38 @@
+package model
+
+type Sidelined struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	PlayerID	int		`gorm:"uniqueIndex:idx_sidelined_player_start"`
+	Type		string	`gorm:"uniqueIndex:idx_sidelined_player_start"`
+	Start		string	`gorm:"uniqueIndex:idx_sidelined_player_start"`
+	End			string
+}
+
+
+type SidelinedDTO struct {
+	Type	string	`json:"type"`
+	Start	string	`json:"start"`
+	End		*string	`json:"end"`
+}
+
+
+type SidelinedResponse struct {
+	Response []SidelinedDTO `json:"response"`
+}
+
+
+type ManchesterUnitedSidelinedDTO struct {
+	Type	string	`json:"type"`
+	Start	string	`json:"start"`
+	End		string	`json:"end"`
+	Days	int		`json:"days"`
+	Ongoing	bool	`json:"ongoing"`
+}
+
+
+type ManchesterUnitedPlayerSidelinedDTO struct {
+	PlayerID	int								`json:"player_id"`
+	DaysOut		int								`json:"days_out"`
+	Periods		[]ManchesterUnitedSidelinedDTO	`json:"periods"`
+}
@@ -2,10 +2,10 @@ package model
 
 type Squad struct {
 	ID        		uint   `gorm:"primaryKey"`
-	TeamID    		int
+	TeamID    		int	`gorm:"uniqueIndex:idx_squad_player_team"`
 	TeamName  		string
 	TeamLogo  		string
-	PlayerID  		int
+	PlayerID  		int	`gorm:"uniqueIndex:idx_squad_player_team"`
 	PlayerName 		string
 	Age       		int
 	Number    		int
@@ -51,7 +51,10 @@ type ManchesterUnitedFootballerDTO struct {
 }
 
 
-type ManchesterUnitedSquadDTO struct {
-	SquadDepth		int								`json:"squad_depth"`
+// ManchesterUnitedSquadPageDTO keeps squad_depth and footballers from the
+// unpaginated squad response next to the page, for clients written against it.
+type ManchesterUnitedSquadPageDTO struct {
+	ManchesterUnitedPageDTO[ManchesterUnitedFootballerDTO]
+	SquadDepth		int64							`json:"squad_depth"`
 	Footballers		[]ManchesterUnitedFootballerDTO	`json:"footballers"`
 }
\ No newline at end of file
@@ -3,11 +3,11 @@ package model
 type Standing struct {
 	ID		uint		`gorm:"primaryKey"`
 
-	LeagueID    int
+	LeagueID    int		`gorm:"uniqueIndex:idx_standing_league_season_team"`
 	LeagueName  string
 	Country     string
-	Season      int
-	TeamID      int
+	Season      int		`gorm:"uniqueIndex:idx_standing_league_season_team"`
+	TeamID      int		`gorm:"uniqueIndex:idx_standing_league_season_team"`
 	TeamName    string
 	TeamLogo    string
 	Rank        int
@@ -102,11 +102,14 @@ type StandingResponse struct {
 type ManchesterUnitedStandingsDTO struct {
 	LeagueName  		string	`json:"league_name"`
 	Season      		int		`json:"season"`
+	TeamID				int		`json:"team_id"`
 	TeamName    		string	`json:"team_name"`
 	Rank        		int		`json:"rank"`
 	Points      		int		`json:"points"`
 	GoalsDiff   		int		`json:"goals_diff"`
 	Description 		string	`json:"description"`
+	Form				string	`json:"form"`
+	Status				string	`json:"status"`
 	PlayedAll 			int		`json:"played_all"`
 	WinsAll   			int		`json:"wins_all"`
 	DrawsAll  			int		`json:"draws_all"`
@@ -125,4 +128,42 @@ type ManchesterUnitedStandingsDTO struct {
 	LosesAway  			int		`json:"loses_away"`
 	GoalsForAway 		int		`json:"goals_for_away"`
 	GoalsAgainstAway 	int		`json:"goals_against_away"`
+}
+
+
+type ManchesterUnitedTablePositionDTO struct {
+	TeamName		string	`json:"team_name"`
+	Rank			int		`json:"rank"`
+	Points			int		`json:"points"`
+	Status			string	`json:"status"`
+	PreviousRank	int		`json:"previous_rank"`
+	RankMovement	int		`json:"rank_movement"`
+	GapToTopFour	int		`json:"gap_to_top_four"`
+}
+
+
+type ManchesterUnitedLeagueTableDTO struct {
+	LeagueName	string								`json:"league_name"`
+	Season		int									`json:"season"`
+	Team		*ManchesterUnitedTablePositionDTO	`json:"team"`
+	Table		[]*ManchesterUnitedStandingsDTO		`json:"table"`
+}
+
+
+type ManchesterUnitedProgressionRoundDTO struct {
+	Round			int	`json:"round"`
+	Played			int	`json:"played"`
+	Rank			int	`json:"rank"`
+	Points			int	`json:"points"`
+	GoalsDiff		int	`json:"goals_diff"`
+	GapToLeader		int	`json:"gap_to_leader"`
+	GapToTopFour	int	`json:"gap_to_top_four"`
+}
+
+
+type ManchesterUnitedStandingsProgressionDTO struct {
+	LeagueName	string									`json:"league_name"`
+	Season		int										`json:"season"`
+	TeamName	string									`json:"team_name"`
+	Rounds		[]ManchesterUnitedProgressionRoundDTO	`json:"rounds"`
 }
\ No newline at end of file
@@ -2,7 +2,7 @@ package model
 
 type Team struct {
 	ID			uint	`gorm:"primaryKey"`
-	TeamID		int			
+	TeamID		int		`gorm:"uniqueIndex"`
 	TeamName	string		
 	Code		string		
 	Country		string		
@@ -3,12 +3,12 @@ package model
 type TeamStats struct {
 	ID			uint	`gorm:"primaryKey"`
 
-	TeamID      int
+	TeamID      int		`gorm:"uniqueIndex:idx_team_stats_season_team"`
 	TeamName    string
-	LeagueID    int
+	LeagueID    int		`gorm:"uniqueIndex:idx_team_stats_season_team"`
 	LeagueName  string
 	Country     string
-	Season      int
+	Season      int		`gorm:"uniqueIndex:idx_team_stats_season_team"`
 	Form        string
 
 	PlayedHome  int
@@ -70,12 +70,55 @@ type TeamStats struct {
 
 type Lineup struct {
 	ID			uint	`gorm:"primaryKey"`
-	Season		int
-	Formation	string
+	TeamID		int		`gorm:"uniqueIndex:idx_lineup_team_season_formation"`
+	Season		int		`gorm:"uniqueIndex:idx_lineup_team_season_formation"`
+	Formation	string	`gorm:"uniqueIndex:idx_lineup_team_season_formation"`
 	Played		int
 }
 
 
+const (
+	GoalsSideFor		= "for"
+	GoalsSideAgainst	= "against"
+
+	CardColorYellow	= "yellow"
+	CardColorRed	= "red"
+)
+
+
+type TeamGoalsMinute struct {
+	ID			uint	`gorm:"primaryKey"`
+	TeamID		int		`gorm:"uniqueIndex:idx_team_goals_minute"`
+	Season		int		`gorm:"uniqueIndex:idx_team_goals_minute"`
+	Side		string	`gorm:"uniqueIndex:idx_team_goals_minute"`
+	Minute		string	`gorm:"uniqueIndex:idx_team_goals_minute"`
+	Total		int
+	Percentage	string
+}
+
+
+type TeamGoalsUnderOver struct {
+	ID			uint	`gorm:"primaryKey"`
+	TeamID		int		`gorm:"uniqueIndex:idx_team_goals_under_over"`
+	Season		int		`gorm:"uniqueIndex:idx_team_goals_under_over"`
+	Side		string	`gorm:"uniqueIndex:idx_team_goals_under_over"`
+	Line		string	`gorm:"uniqueIndex:idx_team_goals_under_over"`
+	Over		int
+	Under		int
+}
+
+
+type TeamCardsMinute struct {
+	ID			uint	`gorm:"primaryKey"`
+	TeamID		int		`gorm:"uniqueIndex:idx_team_cards_minute"`
+	Season		int		`gorm:"uniqueIndex:idx_team_cards_minute"`
+	Color		string	`gorm:"uniqueIndex:idx_team_cards_minute"`
+	Minute		string	`gorm:"uniqueIndex:idx_team_cards_minute"`
+	Total		int
+	Percentage	string
+}
+
+
 type TeamLeagueDTO struct {
 	TeamID		int		`json:"id"`
 	Name		string	`json:"name"`
@@ -352,7 +395,71 @@ type ManchesterUnitedCardsDTO struct {
 }
 
 
+type ManchesterUnitedMinuteDTO struct {
+	Minute		string	`json:"minute"`
+	Total		int		`json:"total"`
+	Percentage	string	`json:"percentage"`
+}
+
+
+type ManchesterUnitedGoalsMinutesDTO struct {
+	Team		ManchesterUnitedTeamStatsDTO	`json:"team"`
+	For			[]ManchesterUnitedMinuteDTO		`json:"for"`
+	Against		[]ManchesterUnitedMinuteDTO		`json:"against"`
+}
+
+
+type ManchesterUnitedUnderOverDTO struct {
+	Line		string	`json:"line"`
+	Over		int		`json:"over"`
+	Under		int		`json:"under"`
+}
+
+
+type ManchesterUnitedGoalsUnderOverDTO struct {
+	Team		ManchesterUnitedTeamStatsDTO	`json:"team"`
+	For			[]ManchesterUnitedUnderOverDTO	`json:"for"`
+	Against		[]ManchesterUnitedUnderOverDTO	`json:"against"`
+}
+
+
+type ManchesterUnitedCardsMinutesDTO struct {
+	Team		ManchesterUnitedTeamStatsDTO	`json:"team"`
+	Yellow		[]ManchesterUnitedMinuteDTO		`json:"yellow"`
+	Red			[]ManchesterUnitedMinuteDTO		`json:"red"`
+}
+
+
 type ManchesterUnitedLineupDTO struct {
 	Team				ManchesterUnitedTeamStatsDTO	`json:"team"`
 	Lineup				map[int][]LineupDTO				`json:"lineups"`
+}
+
+
+type ManchesterUnitedDeltaDTO struct {
+	From		int			`json:"from"`
+	To			int			`json:"to"`
+	Absolute	float64		`json:"absolute"`
+	Percentage	*float64	`json:"percentage"`
+}
+
+
+type ManchesterUnitedComparisonMetricDTO struct {
+	Metric	string						`json:"metric"`
+	Values	[]float64					`json:"values"`
+	Deltas	[]ManchesterUnitedDeltaDTO	`json:"deltas"`
+}
+
+
+type ManchesterUnitedComparisonSectionDTO struct {
+	Section	string									`json:"section"`
+	Metrics	[]ManchesterUnitedComparisonMetricDTO	`json:"metrics"`
+}
+
+
+type ManchesterUnitedTeamStatsComparisonDTO struct {
+	Name		string									`json:"name"`
+	League		string									`json:"league"`
+	Seasons		[]int									`json:"seasons"`
+	Sections	[]ManchesterUnitedComparisonSectionDTO	`json:"sections"`
 }
\ No newline at end of file
@@ -0,0 +1,32 @@
+package model
+
+
+type TrackedTeam struct {
+	ID			uint	`gorm:"primaryKey"`
+	TeamID		int		`gorm:"uniqueIndex"`
+	TeamName	string
+	LeagueID	int
+	LeagueName	string
+	Country		string
+	IsDefault	bool
+}
+
+
+type TrackedTeamDTO struct {
+	TeamID		int		`json:"team_id"`
+	TeamName	string	`json:"team_name"`
+	LeagueID	int		`json:"league_id"`
+	LeagueName	string	`json:"league_name"`
+	Country		string	`json:"country"`
+	IsDefault	bool	`json:"is_default"`
+}
+
+
+var DefaultTrackedTeam = TrackedTeam{
+	TeamID: 		33,
+	TeamName: 		"Manchester United",
+	LeagueID: 		39,
+	LeagueName: 	"Premier League",
+	Country: 		"england",
+	IsDefault: 		true,
+}
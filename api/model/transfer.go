@@ -0,0 +1,74 @@
+package model
+
+const (
+	TransferDirectionIn		= "in"
+	TransferDirectionOut	= "out"
+)
+
+
+type Transfer struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	PlayerID	int		`gorm:"uniqueIndex:idx_transfer"`
+	PlayerName	string
+	Date		string	`gorm:"uniqueIndex:idx_transfer"`
+	Type		string
+
+	TeamInID	int		`gorm:"uniqueIndex:idx_transfer"`
+	TeamInName	string
+	TeamInLogo	string
+	TeamOutID	int		`gorm:"uniqueIndex:idx_transfer"`
+	TeamOutName	string
+	TeamOutLogo	string
+}
+
+
+type TransferPlayerDTO struct {
+	ID		int		`json:"id"`
+	Name	string	`json:"name"`
+}
+
+
+type TransferTeamsDTO struct {
+	In	FixtureTeam	`json:"in"`
+	Out	FixtureTeam	`json:"out"`
+}
+
+
+type TransferEntryDTO struct {
+	Date	string				`json:"date"`
+	Type	*string				`json:"type"`
+	Teams	TransferTeamsDTO	`json:"teams"`
+}
+
+
+type TransferDTO struct {
+	Player		TransferPlayerDTO	`json:"player"`
+	Update		string				`json:"update"`
+	Transfers	[]TransferEntryDTO	`json:"transfers"`
+}
+
+
+type TransferResponse struct {
+	Response []TransferDTO `json:"response"`
+}
+
+
+type TransferFilter struct {
+	Season		int
+	Direction	string
+	PlayerID	int
+}
+
+
+type ManchesterUnitedTransferDTO struct {
+	PlayerID	int		`json:"player_id"`
+	PlayerName	string	`json:"player_name"`
+	Date		string	`json:"date"`
+	Season		int		`json:"season"`
+	Type		string	`json:"type"`
+	Direction	string	`json:"direction"`
+	FromTeam	string	`json:"from_team"`
+	ToTeam		string	`json:"to_team"`
+	InSquad		bool	`json:"in_squad"`
+}
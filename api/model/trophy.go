@@ -0,0 +1,62 @@
+package model
+
+type Trophy struct {
+	ID			uint	`gorm:"primaryKey"`
+
+	PlayerID	int		`gorm:"uniqueIndex:idx_trophy"`
+	CoachID		int		`gorm:"uniqueIndex:idx_trophy"`
+	League		string	`gorm:"uniqueIndex:idx_trophy"`
+	Country		string	`gorm:"uniqueIndex:idx_trophy"`
+	Season		string	`gorm:"uniqueIndex:idx_trophy"`
+	Place		string
+}
+
+
+type TrophyDTO struct {
+	League	string	`json:"league"`
+	Country	string	`json:"country"`
+	Season	string	`json:"season"`
+	Place	string	`json:"place"`
+}
+
+
+type TrophyResponse struct {
+	Response []TrophyDTO `json:"response"`
+}
+
+
+type ManchesterUnitedTrophyDTO struct {
+	League	string	`json:"league"`
+	Country	string	`json:"country"`
+	Season	string	`json:"season"`
+	Place	string	`json:"place"`
+}
+
+
+type ManchesterUnitedPlayerTrophiesDTO struct {
+	PlayerID	int							`json:"player_id"`
+	Titles		int							`json:"titles"`
+	Trophies	[]ManchesterUnitedTrophyDTO	`json:"trophies"`
+}
+
+
+type ManchesterUnitedClubTrophyDTO struct {
+	League		string	`json:"league"`
+	Country		string	`json:"country"`
+	Season		string	`json:"season"`
+	Place		string	`json:"place"`
+	CoachName	string	`json:"coach_name"`
+}
+
+
+// ClubTrophiesAttribution explains how club trophies are derived, API-Football
+// has no trophy list per club.
+const ClubTrophiesAttribution = "approximation: a coach's trophy is credited to the club they were at latest in that season (Aug-Jun, or the calendar year for single year seasons)"
+
+
+type ManchesterUnitedClubTrophiesDTO struct {
+	TeamName	string							`json:"team_name"`
+	Attribution	string							`json:"attribution"`
+	Titles		int								`json:"titles"`
+	Trophies	[]ManchesterUnitedClubTrophyDTO	`json:"trophies"`
+}
@@ -3,7 +3,7 @@ package model
 
 type Venue struct {
 	ID			uint		`gorm:"primaryKey"`
-	VenueID		int			
+	VenueID		int			`gorm:"uniqueIndex"`
 	VenueName	string		
 	Address		string		
 	City		string		
@@ -1,19 +1,105 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/deikioveca/TheRedDevilsData/api/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
 	ErrTeamStatsNotFound = errors.New("team stats for this season not found")
 
 	ErrLineupNotFound = errors.New("lineups for this season not found")
+
+	ErrInvalidSeasonRange = errors.New("season range start must not be after its end")
+
+	ErrSeasonRangeTooWide = fmt.Errorf("season range must not span more than %d seasons", MaxSeasonRange)
+
+	ErrNoStoredFixtures = errors.New("no stored fixtures for these seasons, fetch fixtures first")
+
+	ErrInvalidLeaderboardMetric = errors.New("leaderboard metric must be one of: scorers, assists, yellowcards, redcards")
+
+	ErrInvalidTransferDirection = errors.New("transfer direction must be one of: in, out")
+
+	ErrCoachTenureNotFound = errors.New("coach has no stored tenure at this team")
+
+	ErrNoStoredPlayers = errors.New("no stored players for this team, fetch the squad or player stats first")
+
+	ErrNoStoredCoaches = errors.New("no stored coaches for this team, fetch coaches first")
+
+	ErrInvalidComparisonSection = errors.New("comparison section must be one of: games, goals, streak, cleansheet, failedtoscore, penalty, cards")
+
+	ErrComparisonSeasons = errors.New("comparison needs at least two different seasons")
+
+	ErrSeasonSummaryNotFound = errors.New("no stored team stats, standings or fixtures for this season")
+
+	ErrInvalidListFilter = errors.New("filter is not supported by this endpoint or has an invalid value")
+
+	ErrInvalidListSort = errors.New("sort field is not supported by this endpoint")
+
+	ErrInvalidFixtureVenue = errors.New("fixture venue must be one of: home, away")
+
+	ErrInvalidFixtureResult = errors.New("fixture result must be one of: W, D, L")
+
+	ErrNoLeagueFixtures = errors.New("no stored league fixtures for this season, fetch league fixtures first")
 )
 
 
+var DefaultSeasons = []int{2021, 2022, 2023}
+
+
+// MaxSeasonRange bounds --from/--to, every season in a range costs at least one
+// API-Football request.
+const MaxSeasonRange = 30
+
+
+func SeasonRange(from, to int) ([]int, error) {
+	if from > to {
+		return nil, ErrInvalidSeasonRange
+	}
+
+	if to - from + 1 > MaxSeasonRange {
+		return nil, ErrSeasonRangeTooWide
+	}
+
+	seasons := make([]int, 0, to - from + 1)
+	for season := from; season <= to; season++ {
+		seasons = append(seasons, season)
+	}
+
+	return seasons, nil
+}
+
+
+// UniqueSeasons sorts seasons and drops repeats, a season fetched twice would
+// put the same rows twice into one batch upsert.
+func UniqueSeasons(seasons []int) []int {
+	unique := append([]int{}, seasons...)
+	sort.Ints(unique)
+
+	return slices.Compact(unique)
+}
+
+
+func resolveSeasons(seasons []int) []int {
+	if len(seasons) == 0 {
+		return DefaultSeasons
+	}
+	return UniqueSeasons(seasons)
+}
+
+
 func safeInt(i *int) int {
 	if i == nil {
 		return 0
@@ -38,9 +124,65 @@ func safeBool(ptr *bool) bool {
 }
 
 
-func (s *service) getTeamStatsBySeason(season int) (*model.TeamStats, *model.ManchesterUnitedTeamStatsDTO, error) {
+// statInt reads a /fixtures/statistics value, which API-Football sends as a
+// number, a percentage string such as "55%" or null.
+func statInt(value any) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case string:
+		n, _ := strconv.Atoi(strings.TrimSuffix(v, "%"))
+		return n
+	default:
+		return 0
+	}
+}
+
+
+func statFloat(value any) *float64 {
+	switch v := value.(type) {
+	case float64:
+		return &v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil
+		}
+		return &f
+	default:
+		return nil
+	}
+}
+
+
+func round2(value float64) float64 {
+	return math.Round(value * 100) / 100
+}
+
+
+func upsert(db *gorm.DB, value any, conflictColumns ...string) *gorm.DB {
+	columns := make([]clause.Column, len(conflictColumns))
+	for i, name := range conflictColumns {
+		columns[i] = clause.Column{Name: name}
+	}
+
+	return db.Clauses(clause.OnConflict{Columns: columns, UpdateAll: true}).Create(value)
+}
+
+
+func (s *service) getTeamStatsBySeason(ctx context.Context, teamID, season int) (*model.TeamStats, *model.ManchesterUnitedTeamStatsDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.seasonTeamStats(ctx, team, season)
+}
+
+
+func (s *service) seasonTeamStats(ctx context.Context, team *model.TrackedTeam, season int) (*model.TeamStats, *model.ManchesterUnitedTeamStatsDTO, error) {
 	var teamStats model.TeamStats
-	if err := s.db.Where("season = ?", season).First(&teamStats).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", season, team.TeamID).First(&teamStats).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil, ErrTeamStatsNotFound
 		}
@@ -58,9 +200,191 @@ func (s *service) getTeamStatsBySeason(season int) (*model.TeamStats, *model.Man
 }
 
 
-func (s *service) getLineupsBySeason(season int) ([]model.Lineup, error) {
+func teamStatsGames(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedGamesDTO {
+	return &model.ManchesterUnitedGamesDTO{
+		Team:	team,
+		PlayedHome: 	teamStats.PlayedHome,
+		PlayedAway: 	teamStats.PlayedAway,
+		PlayedTotal: 	teamStats.PlayedTotal,
+		WinsHome:		teamStats.WinsHome,
+		WinsAway:		teamStats.WinsAway,
+		WinsTotal:  	teamStats.WinsTotal,
+		DrawsHome:  	teamStats.DrawsHome,
+		DrawsAway:  	teamStats.DrawsAway,
+		DrawsTotal:  	teamStats.DrawsTotal,
+		LosesHome:   	teamStats.LosesHome,
+		LosesAway:   	teamStats.LosesAway,
+		LosesTotal:  	teamStats.LosesTotal,
+	}
+}
+
+
+func teamStatsGoals(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedGoalsDTO {
+	return &model.ManchesterUnitedGoalsDTO{
+		Team: team,
+		GoalsForHome: 			teamStats.GoalsForHome,
+		GoalsForAway: 			teamStats.GoalsForAway,
+		GoalsForTotal: 			teamStats.GoalsForTotal,
+		GoalsAgainstHome: 		teamStats.GoalsAgainstHome,
+		GoalsAgainstAway: 		teamStats.GoalsAgainstAway,
+		GoalsAgainstTotal: 		teamStats.GoalsAgainstTotal,
+		GoalsForAvgHome: 		teamStats.GoalsForAvgHome,
+		GoalsForAvgAway: 		teamStats.GoalsForAvgAway,
+		GoalsForAvgTotal: 		teamStats.GoalsForAvgTotal,
+		GoalsAgainstAvgHome:	teamStats.GoalsAgainstAvgHome,
+		GoalsAgainstAvgAway: 	teamStats.GoalsAgainstAvgAway,
+		GoalsAgainstAvgTotal: 	teamStats.GoalsAgainstAvgTotal,
+	}
+}
+
+
+func teamStatsStreak(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedStreakDTO {
+	return &model.ManchesterUnitedStreakDTO{
+		Team: team,
+		StreakWins: 	teamStats.StreakWins,
+		StreakDraws: 	teamStats.StreakDraws,
+		StreakLoses: 	teamStats.StreakLoses,
+	}
+}
+
+
+func teamStatsBiggest(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedBiggestDTO {
+	return &model.ManchesterUnitedBiggestDTO{
+		Team:	team,
+		BiggestWinHome: 			teamStats.BiggestWinHome,
+		BiggestWinAway: 			teamStats.BiggestWinAway,
+		BiggestLoseHome: 			teamStats.BiggestLoseHome,
+		BiggestLoseAway: 			teamStats.BiggestLoseAway,
+		BiggestGoalsForHome: 		teamStats.BiggestGoalsForHome,
+		BiggestGoalsForAway: 		teamStats.BiggestGoalsForAway,
+		BiggestGoalsAgainstHome: 	teamStats.BiggestGoalsAgainstHome,
+		BiggestGoalsAgainstAway: 	teamStats.BiggestGoalsAgainstAway,
+	}
+}
+
+
+func teamStatsCleanSheet(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedCleanSheetDTO {
+	return &model.ManchesterUnitedCleanSheetDTO{
+		Team: team,
+		CleanSheetHome: 	teamStats.CleanSheetHome,
+		CleanSheetAway: 	teamStats.CleanSheetAway,
+		CleanSheetTotal: 	teamStats.CleanSheetTotal,
+	}
+}
+
+
+func teamStatsFailedToScore(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedFailedScoringDTO {
+	return &model.ManchesterUnitedFailedScoringDTO{
+		Team: team,
+		FailedToScoreHome: 	teamStats.FailedToScoreHome,
+		FailedToScoreAway: 	teamStats.FailedToScoreAway,
+		FailedToScoreTotal: teamStats.FailedToScoreTotal,
+	}
+}
+
+
+func teamStatsPenalty(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedPenaltyDTO {
+	return &model.ManchesterUnitedPenaltyDTO{
+		Team: team,
+		PenaltyScoredTotal: 	teamStats.PenaltyScoredTotal,
+		PenaltyScoredPct: 		safeString(teamStats.PenaltyScoredPct),
+		PenaltyMissedTotal: 	teamStats.PenaltyMissedTotal,
+		PenaltyMissedPct: 		safeString(teamStats.PenaltyMissedPct),
+		PenaltyTotal: 			teamStats.PenaltyTotal,
+	}
+}
+
+
+func teamStatsCards(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO) *model.ManchesterUnitedCardsDTO {
+	return &model.ManchesterUnitedCardsDTO{
+		Team: team,
+		YellowCardsTotal: 	*teamStats.YellowCardsTotal,
+		RedCardsTotal: 		*teamStats.RedCardsTotal,
+	}
+}
+
+
+func teamStatsLineup(teamStats *model.TeamStats, team model.ManchesterUnitedTeamStatsDTO, lineups []model.Lineup) *model.ManchesterUnitedLineupDTO {
+	lineupDTOs := make(map[int][]model.LineupDTO)
+	for _, l := range lineups {
+		lineupDTOs[teamStats.Season] = append(lineupDTOs[teamStats.Season], model.LineupDTO{Formation: l.Formation, Played: l.Played})
+	}
+
+	return &model.ManchesterUnitedLineupDTO{
+		Team: team,
+		Lineup: lineupDTOs,
+	}
+}
+
+
+var cardMinutes = []string{"0-15", "16-30", "31-45", "46-60", "61-75", "76-90", "91-105", "106-120"}
+
+
+// cardBuckets lists a card distribution in the order of cardMinutes.
+func cardBuckets(d model.CardDistribution) []model.MinuteCardStat {
+	return []model.MinuteCardStat{d.M0_15, d.M16_30, d.M31_45, d.M46_60, d.M61_75, d.M76_90, d.M91_105, d.M106_120}
+}
+
+
+func goalsMinuteRows(teamID, season int, side string, minutes map[string]model.GoalsMinute) []model.TeamGoalsMinute {
+	var rows []model.TeamGoalsMinute
+	for minute, stat := range minutes {
+		rows = append(rows, model.TeamGoalsMinute{
+			TeamID: 	teamID,
+			Season: 	season,
+			Side: 		side,
+			Minute: 	minute,
+			Total: 		safeInt(stat.Total),
+			Percentage: safeString(stat.Percentage),
+		})
+	}
+	return rows
+}
+
+
+func underOverRows(teamID, season int, side string, lines map[string]model.GoalsUnderOver) []model.TeamGoalsUnderOver {
+	var rows []model.TeamGoalsUnderOver
+	for line, stat := range lines {
+		rows = append(rows, model.TeamGoalsUnderOver{
+			TeamID: teamID,
+			Season: season,
+			Side: 	side,
+			Line: 	line,
+			Over: 	stat.Over,
+			Under: 	stat.Under,
+		})
+	}
+	return rows
+}
+
+
+func cardsMinuteRows(teamID, season int, color string, d model.CardDistribution) []model.TeamCardsMinute {
+	var rows []model.TeamCardsMinute
+	for i, stat := range cardBuckets(d) {
+		rows = append(rows, model.TeamCardsMinute{
+			TeamID: 	teamID,
+			Season: 	season,
+			Color: 		color,
+			Minute: 	cardMinutes[i],
+			Total: 		safeInt(stat.Total),
+			Percentage: safeString(stat.Percentage),
+		})
+	}
+	return rows
+}
+
+
+// minuteStart orders minute buckets such as "76-90" by their first minute.
+func minuteStart(minute string) int {
+	start, _, _ := strings.Cut(minute, "-")
+	value, _ := strconv.Atoi(start)
+	return value
+}
+
+
+func (s *service) getLineupsBySeason(ctx context.Context, teamID, season int) ([]model.Lineup, error) {
 	var lineup []model.Lineup
-	if err := s.db.Where("season = ?", season).Find(&lineup).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", season, teamID).Find(&lineup).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrLineupNotFound
 		}
@@ -68,4 +392,1293 @@ func (s *service) getLineupsBySeason(season int) ([]model.Lineup, error) {
 	}
 
 	return lineup, nil
+}
+
+
+// teamFixtures scopes a fixtures query to the team's matches in its tracked
+// league.
+func (s *service) teamFixtures(ctx context.Context, teamID, leagueID int) *gorm.DB {
+	return s.db.WithContext(ctx).Model(&model.Fixture{}).Where("league_id = ? AND (home_team_id = ? OR away_team_id = ?)", leagueID, teamID, teamID)
+}
+
+
+func (s *service) storedFixtureIDs(ctx context.Context, teamID int, seasons []int) ([]int, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtureIDs []int
+	err = s.teamFixtures(ctx, team.TeamID, team.LeagueID).
+		Where("season IN ?", seasons).
+		Order("timestamp").
+		Pluck("fixture_id", &fixtureIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fixtureIDs) == 0 {
+		return nil, ErrNoStoredFixtures
+	}
+
+	return fixtureIDs, nil
+}
+
+
+func leaderboardValue(metric string, entry model.LeaderboardEntry) int {
+	switch metric {
+	case model.LeaderboardAssists:
+		return entry.Assists
+	case model.LeaderboardYellowCards:
+		return entry.YellowCards
+	case model.LeaderboardRedCards:
+		return entry.RedCards
+	default:
+		return entry.Goals
+	}
+}
+
+
+// leaderboardEntries ranks the players that carry statistics, so a skipped
+// player never leaves a gap that an older row could still fill.
+func leaderboardEntries(leagueID, season int, metric string, players []model.PlayerStatsDTO) []model.LeaderboardEntry {
+	var entries []model.LeaderboardEntry
+	for _, dto := range players {
+		if len(dto.Statistics) == 0 {
+			continue
+		}
+
+		st := dto.Statistics[0]
+		for _, candidate := range dto.Statistics {
+			if candidate.League.ID == leagueID {
+				st = candidate
+				break
+			}
+		}
+
+		entry := model.LeaderboardEntry{
+			LeagueID: 		leagueID,
+			Season: 		season,
+			Metric: 		metric,
+			Rank: 			len(entries) + 1,
+			PlayerID: 		dto.Player.ID,
+			PlayerName: 	dto.Player.Name,
+			Nationality: 	safeString(dto.Player.Nationality),
+			TeamID: 		st.Team.ID,
+			TeamName: 		st.Team.Name,
+			Appearances: 	safeInt(st.Games.Appearences),
+			Minutes: 		safeInt(st.Games.Minutes),
+			Goals: 			safeInt(st.Goals.Total),
+			Assists: 		safeInt(st.Goals.Assists),
+			YellowCards: 	safeInt(st.Cards.Yellow),
+			RedCards: 		safeInt(st.Cards.Red),
+		}
+		entry.Value = leaderboardValue(metric, entry)
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+
+func validLeaderboardMetric(metric string) bool {
+	for _, m := range model.LeaderboardMetrics {
+		if m == metric {
+			return true
+		}
+	}
+	return false
+}
+
+
+// transferWindow returns the date range covering the summer window of a season
+// and the winter window that follows it.
+func transferWindow(season int) (string, string) {
+	return fmt.Sprintf("%d-06-01", season), fmt.Sprintf("%d-06-01", season + 1)
+}
+
+
+func transferSeason(date string) int {
+	year, err := strconv.Atoi(date[:min(len(date), 4)])
+	if err != nil {
+		return 0
+	}
+
+	if date < fmt.Sprintf("%d-06-01", year) {
+		return year - 1
+	}
+	return year
+}
+
+
+var finishedStatuses = []string{"FT", "AET", "PEN"}
+
+
+func (s *service) coachTenures(ctx context.Context, coachID, teamID int) ([]model.CoachCareer, error) {
+	var careers []model.CoachCareer
+	if err := s.db.WithContext(ctx).Where("coach_id = ? AND team_id = ?", coachID, teamID).Order("start").Find(&careers).Error; err != nil {
+		return nil, err
+	}
+
+	if len(careers) == 0 {
+		return nil, ErrCoachTenureNotFound
+	}
+
+	return careers, nil
+}
+
+
+// finishedFixtures returns the team's finished league fixtures in kick-off
+// order. A season of 0 returns every stored season and a coachID of 0 skips the
+// tenure filter.
+func (s *service) finishedFixtures(ctx context.Context, teamID, leagueID, season, coachID int) ([]model.Fixture, error) {
+	var careers []model.CoachCareer
+	if coachID != 0 {
+		var err error
+		if careers, err = s.coachTenures(ctx, coachID, teamID); err != nil {
+			return nil, err
+		}
+	}
+
+	query := s.teamFixtures(ctx, teamID, leagueID).Where("status_short IN ?", finishedStatuses)
+	if season != 0 {
+		query = query.Where("season = ?", season)
+	}
+
+	var fixtures []model.Fixture
+	if err := query.Order("timestamp").Find(&fixtures).Error; err != nil {
+		return nil, err
+	}
+
+	if coachID == 0 {
+		return fixtures, nil
+	}
+	return inTenure(fixtures, careers), nil
+}
+
+
+func inTenure(fixtures []model.Fixture, careers []model.CoachCareer) []model.Fixture {
+	var matched []model.Fixture
+	for _, f := range fixtures {
+		day := f.Date[:min(len(f.Date), 10)]
+		for _, c := range careers {
+			if day >= c.Start && (c.End == "" || day <= c.End) {
+				matched = append(matched, f)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+
+func goalsFor(f model.Fixture, teamID int) (int, int) {
+	if f.HomeTeamID == teamID {
+		return f.GoalsHome, f.GoalsAway
+	}
+	return f.GoalsAway, f.GoalsHome
+}
+
+
+func tenureRecord(fixtures []model.Fixture, teamID int) model.ManchesterUnitedTenureRecordDTO {
+	var record model.ManchesterUnitedTenureRecordDTO
+	for _, f := range fixtures {
+		scored, conceded := goalsFor(f, teamID)
+
+		record.Played++
+		record.GoalsFor += scored
+		record.GoalsAgainst += conceded
+		switch {
+		case scored > conceded:
+			record.Wins++
+		case scored == conceded:
+			record.Draws++
+		default:
+			record.Losses++
+		}
+	}
+
+	record.GoalDifference = record.GoalsFor - record.GoalsAgainst
+	if record.Played > 0 {
+		record.PointsPerGame = round2(float64(record.Wins * 3 + record.Draws) / float64(record.Played))
+		record.WinPercentage = round2(float64(record.Wins) * 100 / float64(record.Played))
+	}
+
+	return record
+}
+
+
+func goalsAverage(goals, played int) string {
+	if played == 0 {
+		return "0.0"
+	}
+	return fmt.Sprintf("%.1f", float64(goals) / float64(played))
+}
+
+
+func tenureGames(team model.ManchesterUnitedTeamStatsDTO, fixtures []model.Fixture, teamID int) *model.ManchesterUnitedGamesDTO {
+	games := &model.ManchesterUnitedGamesDTO{Team: team}
+	for _, f := range fixtures {
+		scored, conceded := goalsFor(f, teamID)
+		home := f.HomeTeamID == teamID
+
+		played, wins, draws, loses := &games.PlayedAway, &games.WinsAway, &games.DrawsAway, &games.LosesAway
+		if home {
+			played, wins, draws, loses = &games.PlayedHome, &games.WinsHome, &games.DrawsHome, &games.LosesHome
+		}
+
+		*played++
+		switch {
+		case scored > conceded:
+			*wins++
+		case scored == conceded:
+			*draws++
+		default:
+			*loses++
+		}
+	}
+
+	games.PlayedTotal = games.PlayedHome + games.PlayedAway
+	games.WinsTotal = games.WinsHome + games.WinsAway
+	games.DrawsTotal = games.DrawsHome + games.DrawsAway
+	games.LosesTotal = games.LosesHome + games.LosesAway
+
+	return games
+}
+
+
+func tenureGoals(team model.ManchesterUnitedTeamStatsDTO, fixtures []model.Fixture, teamID int) *model.ManchesterUnitedGoalsDTO {
+	goals := &model.ManchesterUnitedGoalsDTO{Team: team}
+	var playedHome, playedAway int
+	for _, f := range fixtures {
+		scored, conceded := goalsFor(f, teamID)
+		if f.HomeTeamID == teamID {
+			playedHome++
+			goals.GoalsForHome += scored
+			goals.GoalsAgainstHome += conceded
+		} else {
+			playedAway++
+			goals.GoalsForAway += scored
+			goals.GoalsAgainstAway += conceded
+		}
+	}
+
+	goals.GoalsForTotal = goals.GoalsForHome + goals.GoalsForAway
+	goals.GoalsAgainstTotal = goals.GoalsAgainstHome + goals.GoalsAgainstAway
+
+	goals.GoalsForAvgHome = goalsAverage(goals.GoalsForHome, playedHome)
+	goals.GoalsForAvgAway = goalsAverage(goals.GoalsForAway, playedAway)
+	goals.GoalsForAvgTotal = goalsAverage(goals.GoalsForTotal, playedHome + playedAway)
+	goals.GoalsAgainstAvgHome = goalsAverage(goals.GoalsAgainstHome, playedHome)
+	goals.GoalsAgainstAvgAway = goalsAverage(goals.GoalsAgainstAway, playedAway)
+	goals.GoalsAgainstAvgTotal = goalsAverage(goals.GoalsAgainstTotal, playedHome + playedAway)
+
+	return goals
+}
+
+
+// storedPlayerIDs collects everyone in the stored squad or with stored season
+// statistics for the team.
+func (s *service) storedPlayerIDs(ctx context.Context, teamID int) ([]int, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var squadIDs, statsIDs []int
+	if err := s.db.WithContext(ctx).Model(&model.Squad{}).Where("team_id = ?", team.TeamID).Pluck("player_id", &squadIDs).Error; err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Model(&model.PlayerSeasonStats{}).Where("team_id = ?", team.TeamID).Distinct().Pluck("player_id", &statsIDs).Error; err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	var playerIDs []int
+	for _, id := range append(squadIDs, statsIDs...) {
+		if !seen[id] {
+			seen[id] = true
+			playerIDs = append(playerIDs, id)
+		}
+	}
+
+	if len(playerIDs) == 0 {
+		return nil, ErrNoStoredPlayers
+	}
+
+	return playerIDs, nil
+}
+
+
+func (s *service) storedCoachIDs(ctx context.Context, teamID int) ([]int, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var coachIDs []int
+	if err := s.db.WithContext(ctx).Model(&model.CoachCareer{}).Where("team_id = ?", team.TeamID).Distinct().Pluck("coach_id", &coachIDs).Error; err != nil {
+		return nil, err
+	}
+
+	if len(coachIDs) == 0 {
+		return nil, ErrNoStoredCoaches
+	}
+
+	return coachIDs, nil
+}
+
+
+func trophyRows(trophiesResp []*model.TrophyResponse, ids []int, coach bool) []model.Trophy {
+	type trophyKey struct {
+		id							int
+		league, country, season		string
+	}
+
+	seen := make(map[trophyKey]bool)
+	var trophies []model.Trophy
+	for i, resp := range trophiesResp {
+		for _, t := range resp.Response {
+			key := trophyKey{ids[i], t.League, t.Country, t.Season}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			trophy := model.Trophy{
+				League: 	t.League,
+				Country: 	t.Country,
+				Season: 	t.Season,
+				Place: 		t.Place,
+			}
+			if coach {
+				trophy.CoachID = ids[i]
+			} else {
+				trophy.PlayerID = ids[i]
+			}
+			trophies = append(trophies, trophy)
+		}
+	}
+
+	return trophies
+}
+
+
+// trophySeasonSpan turns a trophy season into the dates it covers, August to
+// June for "2022/2023" and the calendar year for "2023". Anything else cannot
+// be placed and reports false.
+func trophySeasonSpan(season string) (string, string, bool) {
+	first, second, split := strings.Cut(season, "/")
+
+	start, err := strconv.Atoi(first)
+	if err != nil || len(first) != 4 {
+		return "", "", false
+	}
+
+	if !split {
+		return fmt.Sprintf("%d-01-01", start), fmt.Sprintf("%d-12-31", start), true
+	}
+
+	if end, err := strconv.Atoi(second); err != nil || end != start + 1 {
+		return "", "", false
+	}
+	return fmt.Sprintf("%d-08-01", start), fmt.Sprintf("%d-06-30", start + 1), true
+}
+
+
+// trophyClub picks the club a coach's trophy belongs to: of the stints that
+// overlap the season, the one that ran latest into it. A coach appointed
+// mid-season keeps the trophy, one who left before it was won does not.
+func trophyClub(careers []model.CoachCareer, start, end string) int {
+	teamID, latest := 0, ""
+	for _, c := range careers {
+		if c.Start > end || (c.End != "" && c.End < start) {
+			continue
+		}
+
+		until := c.End
+		if until == "" || until > end {
+			until = end
+		}
+		if until > latest {
+			teamID, latest = c.TeamID, until
+		}
+	}
+	return teamID
+}
+
+
+func isTitle(place string) bool {
+	return place == "Winner"
+}
+
+
+// sidelinedDays counts the days of a sidelined period, running an ongoing one
+// up to now.
+func sidelinedDays(start, end string, now time.Time) int {
+	from, err := time.Parse(time.DateOnly, start)
+	if err != nil {
+		return 0
+	}
+
+	to := now
+	if end != "" {
+		if to, err = time.Parse(time.DateOnly, end); err != nil {
+			return 0
+		}
+	}
+
+	return max(int(to.Sub(from).Hours() / 24), 0)
+}
+
+
+func coachRows(dtos []model.CoachDTO) ([]model.Coach, []model.CoachCareer) {
+	type careerKey struct {
+		coachID, teamID	int
+		start			string
+	}
+
+	// A coach and their stints can be listed more than once, and postgres rejects
+	// an upsert batch that touches one row more than once.
+	seenCoaches := make(map[int]bool)
+	seenCareers := make(map[careerKey]bool)
+	var coaches []model.Coach
+	var careers []model.CoachCareer
+
+	for _, dto := range dtos {
+		if !seenCoaches[dto.ID] {
+			seenCoaches[dto.ID] = true
+			coaches = append(coaches, model.Coach{
+				CoachID: 		dto.ID,
+				Name: 			dto.Name,
+				Firstname: 		dto.Firstname,
+				Lastname: 		dto.Lastname,
+				Age: 			safeInt(dto.Age),
+				BirthDate: 		safeString(dto.Birth.Date),
+				BirthPlace: 	safeString(dto.Birth.Place),
+				BirthCountry: 	safeString(dto.Birth.Country),
+				Nationality: 	safeString(dto.Nationality),
+				Photo: 			dto.Photo,
+				TeamID: 		dto.Team.ID,
+				TeamName: 		dto.Team.Name,
+			})
+		}
+
+		for _, c := range dto.Career {
+			key := careerKey{dto.ID, c.Team.ID, c.Start}
+			if seenCareers[key] {
+				continue
+			}
+			seenCareers[key] = true
+
+			careers = append(careers, model.CoachCareer{
+				CoachID: 	dto.ID,
+				TeamID: 	c.Team.ID,
+				TeamName: 	c.Team.Name,
+				TeamLogo: 	c.Team.Logo,
+				Start: 		c.Start,
+				End: 		safeString(c.End),
+			})
+		}
+	}
+
+	return coaches, careers
+}
+
+
+func fixtureRow(dto model.FixtureDTO) model.Fixture {
+	fixture := dto.Fixture
+	league 	:= dto.League
+	teams 	:= dto.Teams
+	goals 	:= dto.Goals
+	score 	:= dto.Score
+
+	return model.Fixture{
+		FixtureID:  	fixture.ID,
+		Referee:    	fixture.Referee,
+		Timezone:   	fixture.Timezone,
+		Date:       	fixture.Date,
+		Timestamp:  	fixture.Timestamp,
+		PeriodFirst:  	fixture.Periods.First,
+		PeriodSecond: 	fixture.Periods.Second,
+		VenueID:     	fixture.Venue.ID,
+		VenueName:   	fixture.Venue.Name,
+		VenueCity:   	fixture.Venue.City,
+		StatusLong:   	fixture.Status.Long,
+		StatusShort:  	fixture.Status.Short,
+		StatusElapsed: 	fixture.Status.Elapsed,
+		StatusExtra:   	fixture.Status.Extra,
+
+		LeagueID:   league.ID,
+		LeagueName: league.Name,
+		Country:    league.Country,
+		Season:     league.Season,
+		Round:      league.Round,
+		Standings:  league.Standings,
+
+		HomeTeamID:   teams.Home.ID,
+		HomeTeamName: teams.Home.Name,
+		HomeTeamLogo: teams.Home.Logo,
+		HomeWinner:   teams.Home.Winner,
+		AwayTeamID:   teams.Away.ID,
+		AwayTeamName: teams.Away.Name,
+		AwayTeamLogo: teams.Away.Logo,
+		AwayWinner:   teams.Away.Winner,
+
+		GoalsHome:  safeInt(goals.Home),
+		GoalsAway:  safeInt(goals.Away),
+
+		HalftimeHome:  score.Halftime.Home,
+		HalftimeAway:  score.Halftime.Away,
+		FulltimeHome:  score.Fulltime.Home,
+		FulltimeAway:  score.Fulltime.Away,
+		ExtratimeHome: score.Extratime.Home,
+		ExtratimeAway: score.Extratime.Away,
+		PenaltyHome:   score.Penalty.Home,
+		PenaltyAway:   score.Penalty.Away,
+	}
+}
+
+
+func meeting(f model.Fixture, teamID int) model.ManchesterUnitedMeetingDTO {
+	scored, conceded := goalsFor(f, teamID)
+
+	result := "D"
+	switch {
+	case scored > conceded:
+		result = "W"
+	case scored < conceded:
+		result = "L"
+	}
+
+	return model.ManchesterUnitedMeetingDTO{
+		FixtureID: 		f.FixtureID,
+		Date: 			f.Date,
+		Season: 		f.Season,
+		LeagueName: 	f.LeagueName,
+		HomeTeamName: 	f.HomeTeamName,
+		AwayTeamName: 	f.AwayTeamName,
+		GoalsHome: 		f.GoalsHome,
+		GoalsAway: 		f.GoalsAway,
+		Result: 		result,
+	}
+}
+
+
+// biggerMargin reports whether a was a bigger win than b for winnerID, ranking
+// by goal margin and then by goals scored.
+func biggerMargin(a, b model.Fixture, winnerID int) bool {
+	aFor, aAgainst := goalsFor(a, winnerID)
+	bFor, bAgainst := goalsFor(b, winnerID)
+
+	if aFor - aAgainst != bFor - bAgainst {
+		return aFor - aAgainst > bFor - bAgainst
+	}
+	return aFor > bFor
+}
+
+
+// leagueFixtures returns the finished fixtures of every team in the league. The
+// team's own fixtures alone cannot rebuild a table, so a season without any
+// other match is reported as missing.
+func (s *service) leagueFixtures(ctx context.Context, team *model.TrackedTeam, season int) ([]model.Fixture, error) {
+	var fixtures []model.Fixture
+	err := s.db.WithContext(ctx).
+		Where("league_id = ? AND season = ? AND status_short IN ?", team.LeagueID, season, finishedStatuses).
+		Order("timestamp").
+		Find(&fixtures).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fixtures {
+		if f.HomeTeamID != team.TeamID && f.AwayTeamID != team.TeamID {
+			return fixtures, nil
+		}
+	}
+	return nil, ErrNoLeagueFixtures
+}
+
+
+type leagueRow struct {
+	teamID			int
+	teamName		string
+	played			int
+	points			int
+	goalsFor		int
+	goalsAgainst	int
+}
+
+
+func (r *leagueRow) goalsDiff() int {
+	return r.goalsFor - r.goalsAgainst
+}
+
+
+func (r *leagueRow) addResult(scored, conceded int) {
+	r.played++
+	r.goalsFor += scored
+	r.goalsAgainst += conceded
+
+	switch {
+	case scored > conceded:
+		r.points += 3
+	case scored == conceded:
+		r.points++
+	}
+}
+
+
+func roundNumber(round string) int {
+	number, _ := strconv.Atoi(round[strings.LastIndex(round, " ") + 1:])
+	return number
+}
+
+
+// rebuildTable orders teams by points, goal difference and goals scored, then
+// by the points won in meetings between the teams still level. Clubs without a
+// result yet get an empty row.
+func rebuildTable(fixtures []model.Fixture, clubs map[int]string) []*leagueRow {
+	rows := make(map[int]*leagueRow)
+	row := func(teamID int, teamName string) *leagueRow {
+		if rows[teamID] == nil {
+			rows[teamID] = &leagueRow{teamID: teamID, teamName: teamName}
+		}
+		return rows[teamID]
+	}
+
+	for teamID, teamName := range clubs {
+		row(teamID, teamName)
+	}
+
+	for _, f := range fixtures {
+		row(f.HomeTeamID, f.HomeTeamName).addResult(f.GoalsHome, f.GoalsAway)
+		row(f.AwayTeamID, f.AwayTeamName).addResult(f.GoalsAway, f.GoalsHome)
+	}
+
+	table := make([]*leagueRow, 0, len(rows))
+	for _, r := range rows {
+		table = append(table, r)
+	}
+
+	level := func(a, b *leagueRow) bool {
+		return a.points == b.points && a.goalsDiff() == b.goalsDiff() && a.goalsFor == b.goalsFor
+	}
+
+	sort.Slice(table, func(i, j int) bool {
+		a, b := table[i], table[j]
+		if a.points != b.points {
+			return a.points > b.points
+		}
+		if a.goalsDiff() != b.goalsDiff() {
+			return a.goalsDiff() > b.goalsDiff()
+		}
+		if a.goalsFor != b.goalsFor {
+			return a.goalsFor > b.goalsFor
+		}
+		return a.teamName < b.teamName
+	})
+
+	for start := 0; start < len(table); {
+		end := start + 1
+		for end < len(table) && level(table[start], table[end]) {
+			end++
+		}
+		if end - start > 1 {
+			headToHeadOrder(table[start:end], fixtures)
+		}
+		start = end
+	}
+
+	return table
+}
+
+
+func headToHeadOrder(group []*leagueRow, fixtures []model.Fixture) {
+	points := make(map[int]int, len(group))
+	for _, r := range group {
+		points[r.teamID] = 0
+	}
+
+	for _, f := range fixtures {
+		_, home := points[f.HomeTeamID]
+		_, away := points[f.AwayTeamID]
+		if !home || !away {
+			continue
+		}
+
+		switch {
+		case f.GoalsHome > f.GoalsAway:
+			points[f.HomeTeamID] += 3
+		case f.GoalsHome < f.GoalsAway:
+			points[f.AwayTeamID] += 3
+		default:
+			points[f.HomeTeamID]++
+			points[f.AwayTeamID]++
+		}
+	}
+
+	sort.SliceStable(group, func(i, j int) bool {
+		return points[group[i].teamID] > points[group[j].teamID]
+	})
+}
+
+
+// gapToTopFour is the number of points a team trails fourth place by as a
+// negative value, or its cushion over fifth place once inside the top four.
+func gapToTopFour(index int, points []int) int {
+	if len(points) <= 4 {
+		return 0
+	}
+	if index < 4 {
+		return points[index] - points[4]
+	}
+	return points[index] - points[3]
+}
+
+
+// matchdaySpan is how long after a round's median kickoff its fixtures still
+// count as played on schedule, a weekend round runs from Friday to Monday.
+const matchdaySpan = 4 * 24 * 60 * 60
+
+
+// roundEnd is the kickoff of the last fixture of the round played on its
+// matchday. Fixtures postponed beyond it count from the date they were played.
+func roundEnd(timestamps []int64) int64 {
+	slices.Sort(timestamps)
+	median := timestamps[(len(timestamps) - 1) / 2]
+
+	end := median
+	for _, ts := range timestamps {
+		if ts <= median + matchdaySpan {
+			end = max(end, ts)
+		}
+	}
+	return end
+}
+
+
+// standingsProgression replays finished fixtures in kickoff order and takes
+// the table at the end of every round's matchday, so a postponed fixture only
+// counts once it was played and teams can have played a different number of
+// games, as in the real table. The last round includes every fixture.
+func standingsProgression(fixtures []model.Fixture, teamID int) []model.ManchesterUnitedProgressionRoundDTO {
+	kickoffs := make(map[int][]int64)
+	clubs := make(map[int]string)
+	var rounds []int
+	var scheduled []model.Fixture
+	for _, f := range fixtures {
+		round := roundNumber(f.Round)
+		if round == 0 {
+			continue
+		}
+		if _, ok := kickoffs[round]; !ok {
+			rounds = append(rounds, round)
+		}
+		kickoffs[round] = append(kickoffs[round], f.Timestamp)
+		clubs[f.HomeTeamID] = f.HomeTeamName
+		clubs[f.AwayTeamID] = f.AwayTeamName
+		scheduled = append(scheduled, f)
+	}
+	sort.Ints(rounds)
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduled[i].Timestamp < scheduled[j].Timestamp
+	})
+
+	var played []model.Fixture
+	var cutoff int64
+	progression := []model.ManchesterUnitedProgressionRoundDTO{}
+	for i, round := range rounds {
+		cutoff = max(cutoff, roundEnd(kickoffs[round]))
+		if i == len(rounds) - 1 {
+			cutoff = math.MaxInt64
+		}
+
+		for len(played) < len(scheduled) && scheduled[len(played)].Timestamp <= cutoff {
+			played = append(played, scheduled[len(played)])
+		}
+		table := rebuildTable(played, clubs)
+
+		points := make([]int, len(table))
+		for i, r := range table {
+			points[i] = r.points
+		}
+
+		for i, r := range table {
+			if r.teamID != teamID {
+				continue
+			}
+			progression = append(progression, model.ManchesterUnitedProgressionRoundDTO{
+				Round: 			round,
+				Played: 		r.played,
+				Rank: 			i + 1,
+				Points: 		r.points,
+				GoalsDiff: 		r.goalsDiff(),
+				GapToLeader: 	r.points - points[0],
+				GapToTopFour: 	gapToTopFour(i, points),
+			})
+		}
+	}
+
+	return progression
+}
+
+
+var comparisonSections = []string{"games", "goals", "streak", "cleansheet", "failedtoscore", "penalty", "cards"}
+
+
+type comparisonValue struct {
+	metric	string
+	value	float64
+}
+
+
+// comparisonValues flattens one teamStats section into named values, using the
+// json names of the matching single season endpoint.
+func comparisonValues(section string, t *model.TeamStats) []comparisonValue {
+	switch section {
+	case "games":
+		return []comparisonValue{
+			{"played_home", float64(t.PlayedHome)},
+			{"played_away", float64(t.PlayedAway)},
+			{"played_total", float64(t.PlayedTotal)},
+			{"wins_home", float64(t.WinsHome)},
+			{"wins_away", float64(t.WinsAway)},
+			{"wins_total", float64(t.WinsTotal)},
+			{"draws_home", float64(t.DrawsHome)},
+			{"draws_away", float64(t.DrawsAway)},
+			{"draws_total", float64(t.DrawsTotal)},
+			{"loses_home", float64(t.LosesHome)},
+			{"loses_away", float64(t.LosesAway)},
+			{"loses_total", float64(t.LosesTotal)},
+		}
+	case "goals":
+		return []comparisonValue{
+			{"goals_for_home", float64(t.GoalsForHome)},
+			{"goals_for_away", float64(t.GoalsForAway)},
+			{"goals_for_total", float64(t.GoalsForTotal)},
+			{"goals_against_home", float64(t.GoalsAgainstHome)},
+			{"goals_against_away", float64(t.GoalsAgainstAway)},
+			{"goals_against_total", float64(t.GoalsAgainstTotal)},
+			{"goals_for_avg_home", parseAverage(t.GoalsForAvgHome)},
+			{"goals_for_avg_away", parseAverage(t.GoalsForAvgAway)},
+			{"goals_for_avg_total", parseAverage(t.GoalsForAvgTotal)},
+			{"goals_against_avg_home", parseAverage(t.GoalsAgainstAvgHome)},
+			{"goals_against_avg_away", parseAverage(t.GoalsAgainstAvgAway)},
+			{"goals_against_avg_total", parseAverage(t.GoalsAgainstAvgTotal)},
+		}
+	case "streak":
+		return []comparisonValue{
+			{"streak_wins", float64(t.StreakWins)},
+			{"streak_draws", float64(t.StreakDraws)},
+			{"streak_loses", float64(t.StreakLoses)},
+		}
+	case "cleansheet":
+		return []comparisonValue{
+			{"clean_sheet_home", float64(t.CleanSheetHome)},
+			{"clean_sheet_away", float64(t.CleanSheetAway)},
+			{"clean_sheet_total", float64(t.CleanSheetTotal)},
+		}
+	case "failedtoscore":
+		return []comparisonValue{
+			{"failed_to_score_home", float64(t.FailedToScoreHome)},
+			{"failed_to_score_away", float64(t.FailedToScoreAway)},
+			{"failed_to_score_total", float64(t.FailedToScoreTotal)},
+		}
+	case "penalty":
+		return []comparisonValue{
+			{"penalty_scored_total", float64(t.PenaltyScoredTotal)},
+			{"penalty_missed_total", float64(t.PenaltyMissedTotal)},
+			{"penalty_total", float64(t.PenaltyTotal)},
+		}
+	case "cards":
+		return []comparisonValue{
+			{"yellow_cards_total", float64(safeInt(t.YellowCardsTotal))},
+			{"red_cards_total", float64(safeInt(t.RedCardsTotal))},
+		}
+	}
+	return nil
+}
+
+
+func parseAverage(average string) float64 {
+	value, _ := strconv.ParseFloat(average, 64)
+	return value
+}
+
+
+// seasonDelta leaves the percentage empty when the earlier season is zero.
+func seasonDelta(from, to int, before, after float64) model.ManchesterUnitedDeltaDTO {
+	delta := model.ManchesterUnitedDeltaDTO{From: from, To: to, Absolute: round2(after - before)}
+	if before != 0 {
+		percentage := round2((after - before) / before * 100)
+		delta.Percentage = &percentage
+	}
+	return delta
+}
+
+
+// runConcurrently runs every part at once and returns the first error, which
+// cancels the context of the parts still running.
+func runConcurrently(ctx context.Context, parts ...func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(parts))
+
+	for _, part := range parts {
+		wg.Add(1)
+
+		go func(part func(ctx context.Context) error) {
+			defer wg.Done()
+
+			if err := part(ctx); err != nil {
+				errCh <- err
+				cancel()
+			}
+		}(part)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	if len(errCh) > 0 {
+		return <- errCh
+	}
+
+	return nil
+}
+
+
+// missingIsEmpty lets a summary section stay empty when the season has no
+// stored data for it.
+func missingIsEmpty(err error) error {
+	switch err {
+	case ErrTeamStatsNotFound, ErrStandingNotFound, ErrLineupNotFound:
+		return nil
+	}
+	return err
+}
+
+
+// topInjuries counts the fixtures each player missed, most first, keeping the
+// reason recorded most often for them.
+func topInjuries(injuries []model.Injury, limit int) []model.ManchesterUnitedInjuryCountDTO {
+	missed := make(map[int]int)
+	reasons := make(map[int]map[string]int)
+	names := make(map[int]string)
+
+	for _, i := range injuries {
+		missed[i.PlayerID]++
+		names[i.PlayerID] = i.PlayerName
+		if reasons[i.PlayerID] == nil {
+			reasons[i.PlayerID] = make(map[string]int)
+		}
+		reasons[i.PlayerID][i.Reason]++
+	}
+
+	counts := []model.ManchesterUnitedInjuryCountDTO{}
+	for playerID, fixtures := range missed {
+		reason := ""
+		for r, n := range reasons[playerID] {
+			if n > reasons[playerID][reason] || (n == reasons[playerID][reason] && r < reason) {
+				reason = r
+			}
+		}
+		counts = append(counts, model.ManchesterUnitedInjuryCountDTO{PlayerName: names[playerID], Reason: reason, FixturesMissed: fixtures})
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].FixturesMissed != counts[j].FixturesMissed {
+			return counts[i].FixturesMissed > counts[j].FixturesMissed
+		}
+		return counts[i].PlayerName < counts[j].PlayerName
+	})
+
+	if len(counts) > limit {
+		counts = counts[:limit]
+	}
+	return counts
+}
+
+
+const maxListLimit = 500
+
+
+type listFilter struct {
+	column	string
+	numeric	bool
+}
+
+
+// listFields maps the filter and sort names a list endpoint accepts to their
+// columns. defaultSort is used when the request does not sort.
+type listFields struct {
+	filters		map[string]listFilter
+	sorts		map[string]string
+	defaultSort	[]string
+}
+
+
+var countryListFields = listFields{
+	filters: 		map[string]listFilter{"name": {"name", false}, "code": {"code", false}},
+	sorts: 			map[string]string{"name": "name", "code": "code"},
+	defaultSort: 	[]string{"name"},
+}
+
+
+var venueListFields = listFields{
+	filters: 		map[string]listFilter{"name": {"venue_name", false}, "city": {"city", false}, "surface": {"surface", false}, "capacity": {"capacity", true}},
+	sorts: 			map[string]string{"name": "venue_name", "city": "city", "capacity": "capacity"},
+	defaultSort: 	[]string{"name"},
+}
+
+
+var leagueListFields = listFields{
+	filters: 		map[string]listFilter{"name": {"name", false}, "type": {"type", false}, "country": {"country", false}, "year": {"year", true}},
+	sorts: 			map[string]string{"name": "name", "type": "type", "country": "country", "year": "year", "start": "start"},
+	defaultSort: 	[]string{"-year", "name"},
+}
+
+
+var fixtureListFields = listFields{
+	filters: 		map[string]listFilter{"status": {"status_short", false}, "venue_city": {"venue_city", false}, "venue_name": {"venue_name", false}, "referee": {"referee", false}, "league": {"league_id", true}},
+	sorts: 			map[string]string{"date": "timestamp", "venue_city": "venue_city", "referee": "referee"},
+	defaultSort: 	[]string{"date"},
+}
+
+
+var squadListFields = listFields{
+	filters: 		map[string]listFilter{"name": {"player_name", false}, "position": {"position", false}, "number": {"number", true}, "age": {"age", true}},
+	sorts: 			map[string]string{"name": "player_name", "position": "position", "number": "number", "age": "age"},
+	defaultSort: 	[]string{"number"},
+}
+
+
+// listLimit caps a requested page size. Without ?limit= every row is returned,
+// as the list endpoints did before they were paginated, and -1 tells gorm to
+// leave the LIMIT out.
+func listLimit(limit int) int {
+	if limit == 0 {
+		return -1
+	}
+	return min(limit, maxListLimit)
+}
+
+
+// findPage applies the list query's filters to query, counts the matching rows
+// and loads the requested page of them in the requested order.
+func findPage[T any](query *gorm.DB, q model.ListQuery, fields listFields) ([]T, model.PaginationDTO, error) {
+	for name, values := range q.Filters {
+		filter, ok := fields.filters[name]
+		if !ok {
+			return nil, model.PaginationDTO{}, ErrInvalidListFilter
+		}
+
+		args := make([]any, len(values))
+		for i, value := range values {
+			args[i] = value
+			if filter.numeric {
+				number, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, model.PaginationDTO{}, ErrInvalidListFilter
+				}
+				args[i] = number
+			}
+		}
+		query = query.Where(filter.column + " IN ?", args)
+	}
+	query = query.Session(&gorm.Session{})
+
+	sorts := q.Sort
+	if len(sorts) == 0 {
+		sorts = fields.defaultSort
+	}
+
+	page := query
+	for _, sort := range sorts {
+		name, descending := strings.CutPrefix(sort, "-")
+		column, ok := fields.sorts[name]
+		if !ok {
+			return nil, model.PaginationDTO{}, ErrInvalidListSort
+		}
+		if descending {
+			column += " DESC"
+		}
+		page = page.Order(column)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, model.PaginationDTO{}, err
+	}
+
+	rows := []T{}
+	if err := page.Order("id").Limit(listLimit(q.Limit)).Offset(q.Offset).Find(&rows).Error; err != nil {
+		return nil, model.PaginationDTO{}, err
+	}
+
+	pagination := model.PaginationDTO{Total: total, Limit: max(listLimit(q.Limit), 0), Offset: q.Offset}
+	if next := q.Offset + len(rows); int64(next) < total {
+		pagination.NextOffset = &next
+	}
+
+	return rows, pagination, nil
+}
+
+
+// tenureCondition is the SQL form of inTenure, for queries that paginate.
+func tenureCondition(careers []model.CoachCareer) (string, []any) {
+	var conditions []string
+	var args []any
+	for _, c := range careers {
+		if c.End == "" {
+			conditions = append(conditions, "substr(date, 1, 10) >= ?")
+			args = append(args, c.Start)
+			continue
+		}
+		conditions = append(conditions, "substr(date, 1, 10) BETWEEN ? AND ?")
+		args = append(args, c.Start, c.End)
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+
+// filterFixtures narrows a query over the team's fixtures. Result only
+// matches finished fixtures and a numeric round matches "Regular Season - n"
+// style names.
+func (s *service) filterFixtures(ctx context.Context, query *gorm.DB, teamID int, filter model.FixtureFilter) (*gorm.DB, error) {
+	if filter.Season != 0 {
+		query = query.Where("season = ?", filter.Season)
+	}
+
+	if filter.CoachID != 0 {
+		careers, err := s.coachTenures(ctx, filter.CoachID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		condition, args := tenureCondition(careers)
+		query = query.Where(condition, args...)
+	}
+
+	if filter.OpponentID != 0 {
+		query = query.Where("home_team_id = ? OR away_team_id = ?", filter.OpponentID, filter.OpponentID)
+	}
+
+	switch filter.Venue {
+	case "":
+	case model.FixtureVenueHome:
+		query = query.Where("home_team_id = ?", teamID)
+	case model.FixtureVenueAway:
+		query = query.Where("away_team_id = ?", teamID)
+	default:
+		return nil, ErrInvalidFixtureVenue
+	}
+
+	switch strings.ToUpper(filter.Result) {
+	case "":
+	case "W":
+		query = query.Where("status_short IN ? AND ((home_team_id = ? AND goals_home > goals_away) OR (away_team_id = ? AND goals_away > goals_home))", finishedStatuses, teamID, teamID)
+	case "D":
+		query = query.Where("status_short IN ? AND goals_home = goals_away", finishedStatuses)
+	case "L":
+		query = query.Where("status_short IN ? AND ((home_team_id = ? AND goals_home < goals_away) OR (away_team_id = ? AND goals_away < goals_home))", finishedStatuses, teamID, teamID)
+	default:
+		return nil, ErrInvalidFixtureResult
+	}
+
+	if filter.Round != "" {
+		if _, err := strconv.Atoi(filter.Round); err == nil {
+			query = query.Where("round LIKE ?", "% - " + filter.Round)
+		} else {
+			query = query.Where("round = ?", filter.Round)
+		}
+	}
+
+	if !filter.From.IsZero() {
+		query = query.Where("timestamp >= ?", filter.From.Unix())
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("timestamp < ?", filter.To.AddDate(0, 0, 1).Unix())
+	}
+
+	return query, nil
+}
+
+
+// meetings returns the finished meetings between two teams newest first, the
+// league fixtures merged with the ones fetch-h2h stored.
+func (s *service) meetings(ctx context.Context, teamID, opponentID int) ([]model.Fixture, error) {
+	pairing := s.db.WithContext(ctx).
+		Where("status_short IN ?", finishedStatuses).
+		Where("(home_team_id = ? AND away_team_id = ?) OR (home_team_id = ? AND away_team_id = ?)", teamID, opponentID, opponentID, teamID)
+
+	var fixtures []model.Fixture
+	if err := pairing.Session(&gorm.Session{}).Find(&fixtures).Error; err != nil {
+		return nil, err
+	}
+
+	var stored []model.HeadToHeadMeeting
+	if err := pairing.Session(&gorm.Session{}).Find(&stored).Error; err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool, len(fixtures))
+	for _, f := range fixtures {
+		seen[f.FixtureID] = true
+	}
+	for _, m := range stored {
+		if !seen[m.FixtureID] {
+			fixtures = append(fixtures, model.Fixture(m))
+		}
+	}
+
+	sort.Slice(fixtures, func(i, j int) bool {
+		return fixtures[i].Timestamp > fixtures[j].Timestamp
+	})
+
+	return fixtures, nil
+}
+
+
+// seasonPosition reads the team's standing and the fourth and fifth placed rows
+// its gap to the top four needs. It leaves out the rank movement, which takes a
+// round by round rebuild of the table.
+func (s *service) seasonPosition(ctx context.Context, team *model.TrackedTeam, season int) (*model.ManchesterUnitedTablePositionDTO, error) {
+	var rows []model.Standing
+	err := s.db.WithContext(ctx).
+		Where("league_id = ? AND season = ? AND (team_id = ? OR rank IN ?)", team.LeagueID, season, team.TeamID, []int{4, 5}).
+		Order("rank").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var standing *model.Standing
+	points := make([]int, 0, 5)
+	for i, r := range rows {
+		if r.Rank < 1 {
+			continue
+		}
+		for len(points) < r.Rank {
+			points = append(points, 0)
+		}
+		points[r.Rank - 1] = r.Points
+		if r.TeamID == team.TeamID {
+			standing = &rows[i]
+		}
+	}
+
+	if standing == nil {
+		return nil, ErrStandingNotFound
+	}
+
+	return &model.ManchesterUnitedTablePositionDTO{
+		TeamName: 		standing.TeamName,
+		Rank: 			standing.Rank,
+		Points: 		standing.Points,
+		Status: 		standing.Status,
+		GapToTopFour: 	gapToTopFour(standing.Rank - 1, points),
+	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+)
+
+
+var testTeamNames = map[int]string{1: "Alpha", 2: "Bravo", 3: "Charlie", 4: "Delta"}
+
+
+func played(home, away, goalsHome, goalsAway int) model.Fixture {
+	return model.Fixture{
+		HomeTeamID: 	home,
+		HomeTeamName: 	testTeamNames[home],
+		AwayTeamID: 	away,
+		AwayTeamName: 	testTeamNames[away],
+		GoalsHome: 		goalsHome,
+		GoalsAway: 		goalsAway,
+	}
+}
+
+
+func tableOrder(table []*leagueRow) []int {
+	order := make([]int, len(table))
+	for i, r := range table {
+		order[i] = r.teamID
+	}
+	return order
+}
+
+
+func TestRebuildTable(t *testing.T) {
+	tests := []struct {
+		name		string
+		fixtures	[]model.Fixture
+		want		[]int
+	}{
+		{
+			name: 		"points",
+			fixtures: 	[]model.Fixture{played(1, 2, 2, 0), played(1, 3, 1, 0), played(2, 3, 1, 0)},
+			want: 		[]int{1, 2, 3},
+		},
+		{
+			name: 		"goal difference breaks level points",
+			fixtures: 	[]model.Fixture{played(2, 3, 3, 0), played(1, 4, 1, 0)},
+			want: 		[]int{2, 1, 4, 3},
+		},
+		{
+			name: 		"goals scored breaks level goal difference",
+			fixtures: 	[]model.Fixture{played(2, 3, 3, 2), played(1, 4, 1, 0)},
+			want: 		[]int{2, 1, 3, 4},
+		},
+		{
+			name: 		"head-to-head breaks level goals scored",
+			fixtures: 	[]model.Fixture{played(2, 1, 1, 0), played(2, 3, 1, 2), played(1, 4, 2, 1)},
+			want: 		[]int{3, 2, 1, 4},
+		},
+		{
+			name: 		"name when head-to-head is level",
+			fixtures: 	[]model.Fixture{played(2, 1, 1, 1)},
+			want: 		[]int{1, 2},
+		},
+		{
+			name: 		"three-way head-to-head",
+			fixtures: 	[]model.Fixture{played(2, 1, 1, 0), played(1, 3, 1, 0), played(3, 2, 1, 0), played(3, 4, 0, 0), played(4, 1, 0, 0), played(4, 2, 0, 0)},
+			want: 		[]int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tableOrder(rebuildTable(tt.fixtures, nil)); !slices.Equal(got, tt.want) {
+				t.Errorf("rebuildTable() order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestRebuildTableKeepsClubsWithoutResults(t *testing.T) {
+	table := rebuildTable([]model.Fixture{played(1, 2, 2, 0)}, testTeamNames)
+
+	if got, want := tableOrder(table), []int{1, 3, 4, 2}; !slices.Equal(got, want) {
+		t.Errorf("rebuildTable() order = %v, want %v", got, want)
+	}
+	if table[1].played != 0 || table[1].points != 0 {
+		t.Errorf("club without results = %d played, %d points, want 0, 0", table[1].played, table[1].points)
+	}
+}
+
+
+func onMatchday(round, day int, f model.Fixture) model.Fixture {
+	f.Round = "Regular Season - " + strconv.Itoa(round)
+	f.Timestamp = int64(day) * 24 * 60 * 60
+	return f
+}
+
+
+func TestStandingsProgression(t *testing.T) {
+	type snapshot struct {
+		round, played, points, rank	int
+	}
+
+	tests := []struct {
+		name		string
+		fixtures	[]model.Fixture
+		want		[]snapshot
+	}{
+		{
+			name: 		"rounds on schedule",
+			fixtures: 	[]model.Fixture{
+				onMatchday(1, 0, played(1, 3, 0, 1)), onMatchday(1, 1, played(2, 4, 1, 0)),
+				onMatchday(2, 7, played(3, 2, 0, 0)), onMatchday(2, 8, played(4, 1, 2, 0)),
+			},
+			want: 		[]snapshot{{1, 1, 3, 2}, {2, 2, 4, 2}},
+		},
+		{
+			name: 		"postponed fixture counts once it is played",
+			fixtures: 	[]model.Fixture{
+				onMatchday(1, 0, played(1, 2, 1, 0)), onMatchday(2, 7, played(1, 3, 0, 0)), onMatchday(2, 7, played(2, 4, 2, 0)),
+				onMatchday(1, 10, played(3, 4, 3, 0)),
+				onMatchday(3, 14, played(1, 4, 1, 0)), onMatchday(3, 15, played(2, 3, 1, 1)),
+			},
+			want: 		[]snapshot{{1, 0, 0, 2}, {2, 1, 1, 3}, {3, 3, 5, 2}},
+		},
+		{
+			name: 		"fixture brought forward counts from its date",
+			fixtures: 	[]model.Fixture{
+				onMatchday(1, 0, played(1, 2, 1, 0)), onMatchday(1, 1, played(3, 4, 0, 2)),
+				onMatchday(2, 0, played(3, 1, 2, 0)), onMatchday(2, 7, played(2, 4, 0, 0)),
+			},
+			want: 		[]snapshot{{1, 2, 3, 2}, {2, 2, 3, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progression := standingsProgression(tt.fixtures, 3)
+
+			got := make([]snapshot, len(progression))
+			for i, p := range progression {
+				got[i] = snapshot{p.Round, p.Played, p.Points, p.Rank}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("standingsProgression() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestHeadToHeadOrder(t *testing.T) {
+	tests := []struct {
+		name		string
+		group		[]int
+		fixtures	[]model.Fixture
+		want		[]int
+	}{
+		{
+			name: 		"winner moves up",
+			group: 		[]int{1, 2},
+			fixtures: 	[]model.Fixture{played(1, 2, 0, 1)},
+			want: 		[]int{2, 1},
+		},
+		{
+			name: 		"meetings with teams outside the group are ignored",
+			group: 		[]int{1, 2},
+			fixtures: 	[]model.Fixture{played(1, 3, 0, 5), played(2, 3, 4, 0), played(1, 2, 2, 2)},
+			want: 		[]int{1, 2},
+		},
+		{
+			name: 		"points over both legs, level teams keep their order",
+			group: 		[]int{1, 2, 3},
+			fixtures: 	[]model.Fixture{played(1, 2, 1, 0), played(2, 1, 2, 0), played(3, 1, 1, 0), played(2, 3, 0, 1)},
+			want: 		[]int{3, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := make([]*leagueRow, len(tt.group))
+			for i, id := range tt.group {
+				group[i] = &leagueRow{teamID: id, teamName: testTeamNames[id]}
+			}
+
+			headToHeadOrder(group, tt.fixtures)
+
+			if got := tableOrder(group); !slices.Equal(got, tt.want) {
+				t.Errorf("headToHeadOrder() order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestGapToTopFour(t *testing.T) {
+	tests := []struct {
+		name	string
+		index	int
+		points	[]int
+		want	int
+	}{
+		{"leader's cushion over fifth", 0, []int{10, 8, 6, 5, 3}, 7},
+		{"fourth's cushion over fifth", 3, []int{10, 8, 6, 5, 3}, 2},
+		{"fifth trails fourth", 4, []int{10, 8, 6, 5, 3}, -2},
+		{"bottom trails fourth", 5, []int{10, 8, 6, 5, 3, 1}, -4},
+		{"level with fourth", 4, []int{10, 8, 6, 5, 5}, 0},
+		{"four teams", 3, []int{9, 6, 3, 0}, 0},
+		{"four teams leader", 0, []int{9, 6, 3, 0}, 0},
+		{"single team", 0, []int{3}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gapToTopFour(tt.index, tt.points); got != tt.want {
+				t.Errorf("gapToTopFour(%d, %v) = %d, want %d", tt.index, tt.points, got, tt.want)
+			}
+		})
+	}
+}
+
+
+func TestTrophySeasonSpan(t *testing.T) {
+	tests := []struct {
+		season		string
+		start		string
+		end			string
+		ok			bool
+	}{
+		{"2022/2023", "2022-08-01", "2023-06-30", true},
+		{"2023", "2023-01-01", "2023-12-31", true},
+		{"", "", "", false},
+		{"2022/2024", "", "", false},
+		{"22/23", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.season, func(t *testing.T) {
+			start, end, ok := trophySeasonSpan(tt.season)
+			if start != tt.start || end != tt.end || ok != tt.ok {
+				t.Errorf("trophySeasonSpan(%q) = %q, %q, %v, want %q, %q, %v", tt.season, start, end, ok, tt.start, tt.end, tt.ok)
+			}
+		})
+	}
+}
+
+
+func TestTrophyClub(t *testing.T) {
+	tests := []struct {
+		name		string
+		careers		[]model.CoachCareer
+		want		int
+	}{
+		{"whole season", []model.CoachCareer{{TeamID: 33, Start: "2020-07-01"}}, 33},
+		{"appointed mid-season", []model.CoachCareer{{TeamID: 40, Start: "2021-07-01", End: "2022-11-30"}, {TeamID: 33, Start: "2022-12-15"}}, 33},
+		{"left mid-season", []model.CoachCareer{{TeamID: 33, Start: "2021-07-01", End: "2022-10-01"}, {TeamID: 40, Start: "2022-10-10"}}, 40},
+		{"no stint in the season", []model.CoachCareer{{TeamID: 33, Start: "2015-07-01", End: "2019-06-30"}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trophyClub(tt.careers, "2022-08-01", "2023-06-30"); got != tt.want {
+				t.Errorf("trophyClub() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func scorer(id, goals int, leagueIDs ...int) model.PlayerStatsDTO {
+	dto := model.PlayerStatsDTO{Player: model.PlayerInfoDTO{ID: id, Name: testTeamNames[id]}}
+	for _, leagueID := range leagueIDs {
+		dto.Statistics = append(dto.Statistics, model.PlayerStatisticsDTO{
+			Team: 	model.FixtureTeam{ID: 33},
+			League: model.FixtureLeague{ID: leagueID},
+			Goals: 	model.PlayerGoalsDTO{Total: &goals},
+		})
+	}
+	return dto
+}
+
+
+func TestLeaderboardEntries(t *testing.T) {
+	tests := []struct {
+		name		string
+		players		[]model.PlayerStatsDTO
+		wantIDs		[]int
+		wantValues	[]int
+	}{
+		{"ranked in response order", []model.PlayerStatsDTO{scorer(1, 20, 39), scorer(2, 15, 39), scorer(3, 10, 39)}, []int{1, 2, 3}, []int{20, 15, 10}},
+		{"player without statistics leaves no gap", []model.PlayerStatsDTO{scorer(1, 20, 39), scorer(2, 15), scorer(3, 10, 39)}, []int{1, 3}, []int{20, 10}},
+		{"no players", nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := leaderboardEntries(39, 2023, model.LeaderboardScorers, tt.players)
+			if len(entries) != len(tt.wantIDs) {
+				t.Fatalf("leaderboardEntries() returned %d entries, want %d", len(entries), len(tt.wantIDs))
+			}
+
+			for i, entry := range entries {
+				if entry.Rank != i+1 || entry.PlayerID != tt.wantIDs[i] || entry.Value != tt.wantValues[i] {
+					t.Errorf("entry %d = rank %d, player %d, value %d, want rank %d, player %d, value %d", i, entry.Rank, entry.PlayerID, entry.Value, i+1, tt.wantIDs[i], tt.wantValues[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCoachRows(t *testing.T) {
+	stint := func(teamID int, start string) model.CoachCareerDTO {
+		return model.CoachCareerDTO{Team: model.FixtureTeam{ID: teamID}, Start: start}
+	}
+
+	dtos := []model.CoachDTO{
+		{ID: 1, Career: []model.CoachCareerDTO{stint(33, "2022-05-01"), stint(40, "2019-07-01"), stint(33, "2022-05-01")}},
+		{ID: 2, Career: []model.CoachCareerDTO{stint(33, "2019-07-01")}},
+		{ID: 1, Career: []model.CoachCareerDTO{stint(33, "2022-05-01"), stint(45, "2017-07-01")}},
+	}
+
+	coaches, careers := coachRows(dtos)
+
+	if len(coaches) != 2 || coaches[0].CoachID != 1 || coaches[1].CoachID != 2 {
+		t.Errorf("coachRows() coaches = %v, want coaches 1 and 2 once each", coaches)
+	}
+
+	type careerKey struct {
+		coachID, teamID	int
+		start			string
+	}
+	want := []careerKey{{1, 33, "2022-05-01"}, {1, 40, "2019-07-01"}, {2, 33, "2019-07-01"}, {1, 45, "2017-07-01"}}
+
+	got := make([]careerKey, len(careers))
+	for i, c := range careers {
+		got[i] = careerKey{c.CoachID, c.TeamID, c.Start}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("coachRows() careers = %v, want %v", got, want)
+	}
+}
\ No newline at end of file
@@ -1,310 +1,474 @@
 package service
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/deikioveca/TheRedDevilsData/api/model"
+	"gorm.io/gorm"
 )
 
 
+type ImportError struct {
+	Entity	string
+	Err		error
+}
+
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("failed to import %s: %v", e.Entity, e.Err)
+}
+
+
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+
 type DataImporter interface {
-	SaveCountries() (*model.CountryResponse, error)
-	SaveLeaguesForTeam() (*model.LeagueResponse, error)
-	SaveTeam() (*model.TeamResponse, error)
-	SaveTeamStats() ([]*model.TeamStatsResponse, error)
-	SaveVenues() (*model.VenueResponse, error)
-	SaveStandings() ([]*model.StandingResponse, error)
-	SaveFixtures() ([]*model.FixtureResponse, error)
-	SaveInjuries() ([]*model.InjuryResponse, error)
-	SaveSquad() (*model.SquadResponse, error)
+	SaveCountries(ctx context.Context) (*model.CountryResponse, error)
+	SaveLeaguesForTeam(ctx context.Context, teamID int) (*model.LeagueResponse, error)
+	SaveTeam(ctx context.Context, teamID int) (*model.TeamResponse, error)
+	SaveTeamStats(ctx context.Context, teamID int, seasons []int) ([]*model.TeamStatsResponse, error)
+	SaveVenues(ctx context.Context, teamID int) (*model.VenueResponse, error)
+	SaveStandings(ctx context.Context, teamID int, seasons []int) ([]*model.StandingResponse, error)
+	SaveFixtures(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureResponse, error)
+	SaveLeagueFixtures(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureResponse, error)
+	SaveInjuries(ctx context.Context, teamID int, seasons []int) ([]*model.InjuryResponse, error)
+	SaveSquad(ctx context.Context, teamID int) (*model.SquadResponse, error)
+	SaveFixtureEvents(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureEventResponse, error)
+	SaveFixtureLineups(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureLineupResponse, error)
+	SaveFixtureStatistics(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureStatisticsResponse, error)
+	SavePlayerStats(ctx context.Context, teamID int, seasons []int) ([]*model.PlayerStatsResponse, error)
+	SaveLeaderboards(ctx context.Context, teamID int, seasons []int) (map[string][]*model.PlayerStatsResponse, error)
+	SaveTransfers(ctx context.Context, teamID int) (*model.TransferResponse, error)
+	SaveCoaches(ctx context.Context, teamID int) (*model.CoachResponse, error)
+	SavePlayerTrophies(ctx context.Context, teamID int) ([]*model.TrophyResponse, error)
+	SaveCoachTrophies(ctx context.Context, teamID int) ([]*model.TrophyResponse, error)
+	SaveSidelined(ctx context.Context, teamID int) ([]*model.SidelinedResponse, error)
+	SaveHeadToHead(ctx context.Context, teamID, opponentID int) (*model.FixtureResponse, error)
 }
 
 
-func (s *service) SaveCountries() (*model.CountryResponse, error) {
-	countries, err := s.client.FetchCountries()
+func (s *service) importInTx(ctx context.Context, entity string, fn func(tx *gorm.DB) error) error {
+	if err := s.db.WithContext(ctx).Transaction(fn); err != nil {
+		return &ImportError{Entity: entity, Err: err}
+	}
+	return nil
+}
+
+
+func (s *service) SaveCountries(ctx context.Context) (*model.CountryResponse, error) {
+	countries, err := s.client.FetchCountries(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, c := range countries.Response {
-		country := &model.Country{
-			Name: c.Name,
-			Code: c.Code,
+	err = s.importInTx(ctx, "countries", func(tx *gorm.DB) error {
+		for _, c := range countries.Response {
+			country := &model.Country{
+				Name: c.Name,
+				Code: c.Code,
+			}
+			if err := upsert(tx, country, "name").Error; err != nil {
+				return fmt.Errorf("country %s: %w", country.Name, err)
+			}
 		}
-		s.db.Create(&country)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return countries, nil
 }
 
 
-func (s *service) SaveLeaguesForTeam() (*model.LeagueResponse, error) {
-	leagues, err := s.client.FetchAllLeaguesForTeam()
+func (s *service) SaveLeaguesForTeam(ctx context.Context, teamID int) (*model.LeagueResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	leagues, err := s.client.FetchAllLeaguesForTeam(ctx, team.TeamID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, leagueDTO := range leagues.Response {
-		for _, season := range leagueDTO.Seasons {
-			league := &model.League{
-				LeagueID: 		leagueDTO.League.LeagueID,
-				Name: 			leagueDTO.League.Name,
-				Type: 			leagueDTO.League.Type,
-				Country: 		leagueDTO.Country.Name,
-				CountryCode: 	leagueDTO.Country.Code,
-				Year: 			season.Year,
-				Start: 			season.Start,
-				End: 			season.End,
-				Current: 		season.Current,
-				TeamID: 		33,
+	err = s.importInTx(ctx, "leagues", func(tx *gorm.DB) error {
+		for _, leagueDTO := range leagues.Response {
+			for _, season := range leagueDTO.Seasons {
+				league := &model.League{
+					LeagueID: 		leagueDTO.League.LeagueID,
+					Name: 			leagueDTO.League.Name,
+					Type: 			leagueDTO.League.Type,
+					Country: 		leagueDTO.Country.Name,
+					CountryCode: 	leagueDTO.Country.Code,
+					Year: 			season.Year,
+					Start: 			season.Start,
+					End: 			season.End,
+					Current: 		season.Current,
+					TeamID: 		team.TeamID,
+				}
+				if err := upsert(tx, league, "league_id", "year", "team_id").Error; err != nil {
+					return fmt.Errorf("league %d season %d: %w", league.LeagueID, league.Year, err)
+				}
 			}
-			s.db.Create(&league)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return leagues, nil
 }
 
 
-func (s *service) SaveTeam() (*model.TeamResponse, error) {
-	team, err := s.client.FetchTeam()
+func (s *service) SaveTeam(ctx context.Context, teamID int) (*model.TeamResponse, error) {
+	trackedTeam, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.client.FetchTeam(ctx, trackedTeam.TeamID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, res := range team.Response {
-		manchesterUnited := &model.Team{
-			TeamID: 	res.Team.TeamID,
-			TeamName: 	res.Team.TeamName,
-			Code: 		res.Team.Code,
-			Country: 	res.Team.Country,
-			Founded: 	res.Team.Founded,
-			National: 	res.Team.National,
-			VenueID: 	res.Venue.VenueID,
-			VenueName: 	res.Venue.VenueName,
-			Address: 	res.Venue.Address,
-			City: 		res.Venue.City,
-			Capacity: 	res.Venue.Capacity,
-			Surface: 	res.Venue.Surface,
+	err = s.importInTx(ctx, "team", func(tx *gorm.DB) error {
+		for _, res := range team.Response {
+			teamRecord := &model.Team{
+				TeamID: 	res.Team.TeamID,
+				TeamName: 	res.Team.TeamName,
+				Code: 		res.Team.Code,
+				Country: 	res.Team.Country,
+				Founded: 	res.Team.Founded,
+				National: 	res.Team.National,
+				VenueID: 	res.Venue.VenueID,
+				VenueName: 	res.Venue.VenueName,
+				Address: 	res.Venue.Address,
+				City: 		res.Venue.City,
+				Capacity: 	res.Venue.Capacity,
+				Surface: 	res.Venue.Surface,
+			}
+			if err := upsert(tx, teamRecord, "team_id").Error; err != nil {
+				return fmt.Errorf("team %d: %w", teamRecord.TeamID, err)
+			}
 		}
-		s.db.Create(&manchesterUnited)
-	} 
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return team, nil
 }
 
 
-func (s *service) SaveTeamStats() ([]*model.TeamStatsResponse, error) {
-	stats, err := s.client.FetchTeamStats(33, 39)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, seasonStats := range stats {
-		if seasonStats == nil {
-			continue
-		}
-
-		resp := seasonStats.Response
-
-		teamStats := &model.TeamStats{
-			TeamID:     resp.Team.ID,
-			TeamName:   resp.Team.Name,
-			LeagueID:   resp.League.TeamID, 
-			LeagueName: resp.League.Name,
-			Country:    resp.League.Country,
-			Season:     resp.League.Season,
-			Form:       resp.Form,
-
-			PlayedHome:  resp.Fixtures.Played.Home,
-			PlayedAway:  resp.Fixtures.Played.Away,
-			PlayedTotal: resp.Fixtures.Played.Total,
-			WinsHome:    resp.Fixtures.Wins.Home,
-			WinsAway:    resp.Fixtures.Wins.Away,
-			WinsTotal:   resp.Fixtures.Wins.Total,
-			DrawsHome:   resp.Fixtures.Draws.Home,
-			DrawsAway:   resp.Fixtures.Draws.Away,
-			DrawsTotal:  resp.Fixtures.Draws.Total,
-			LosesHome:   resp.Fixtures.Loses.Home,
-			LosesAway:   resp.Fixtures.Loses.Away,
-			LosesTotal:  resp.Fixtures.Loses.Total,
-
-			GoalsForHome:      resp.Goals.For.Total.Home,
-			GoalsForAway:      resp.Goals.For.Total.Away,
-			GoalsForTotal:     resp.Goals.For.Total.Total,
-			GoalsAgainstHome:  resp.Goals.Against.Total.Home,
-			GoalsAgainstAway:  resp.Goals.Against.Total.Away,
-			GoalsAgainstTotal: resp.Goals.Against.Total.Total,
-
-			GoalsForAvgHome:      resp.Goals.For.Average.Home,
-			GoalsForAvgAway:      resp.Goals.For.Average.Away,
-			GoalsForAvgTotal:     resp.Goals.For.Average.Total,
-			GoalsAgainstAvgHome:  resp.Goals.Against.Average.Home,
-			GoalsAgainstAvgAway:  resp.Goals.Against.Average.Away,
-			GoalsAgainstAvgTotal: resp.Goals.Against.Average.Total,
-
-			StreakWins:             resp.Biggest.Streak.Wins,
-			StreakDraws:            resp.Biggest.Streak.Draws,
-			StreakLoses:            resp.Biggest.Streak.Loses,
-			BiggestWinHome:         resp.Biggest.Wins.Home,
-			BiggestWinAway:         resp.Biggest.Wins.Away,
-			BiggestLoseHome:        resp.Biggest.Loses.Home,
-			BiggestLoseAway:        resp.Biggest.Loses.Away,
-			BiggestGoalsForHome:    resp.Biggest.Goals.For.Home,
-			BiggestGoalsForAway:    resp.Biggest.Goals.For.Away,
-			BiggestGoalsAgainstHome: resp.Biggest.Goals.Against.Home,
-			BiggestGoalsAgainstAway: resp.Biggest.Goals.Against.Away,
-
-			CleanSheetHome:     resp.CleanSheet.Home,
-			CleanSheetAway:     resp.CleanSheet.Away,
-			CleanSheetTotal:    resp.CleanSheet.Total,
-			FailedToScoreHome:  resp.FailedToScore.Home,
-			FailedToScoreAway:  resp.FailedToScore.Away,
-			FailedToScoreTotal: resp.FailedToScore.Total,
-
-			PenaltyScoredTotal: resp.Penalty.Scored.Total,
-			PenaltyScoredPct:   resp.Penalty.Scored.Percentage,
-			PenaltyMissedTotal: resp.Penalty.Missed.Total,
-			PenaltyMissedPct:   resp.Penalty.Missed.Percentage,
-			PenaltyTotal:       resp.Penalty.Total,
-		}
-
-		yellow := 0
-		red := 0
-
-		for _, minuteRange := range []model.MinuteCardStat{
-			resp.Cards.Yellow.M0_15,
-			resp.Cards.Yellow.M16_30,
-			resp.Cards.Yellow.M31_45,
-			resp.Cards.Yellow.M46_60,
-			resp.Cards.Yellow.M61_75,
-			resp.Cards.Yellow.M76_90,
-			resp.Cards.Yellow.M91_105,
-			resp.Cards.Yellow.M106_120,
-		} {
-			if minuteRange.Total != nil {
-				yellow += *minuteRange.Total
-			}
-		}
-
-		for _, minuteRange := range []model.MinuteCardStat{
-			resp.Cards.Red.M0_15,
-			resp.Cards.Red.M16_30,
-			resp.Cards.Red.M31_45,
-			resp.Cards.Red.M46_60,
-			resp.Cards.Red.M61_75,
-			resp.Cards.Red.M76_90,
-			resp.Cards.Red.M91_105,
-			resp.Cards.Red.M106_120,
-		} {
-			if minuteRange.Total != nil {
-				red += *minuteRange.Total
-			}
-		}
-
-		teamStats.YellowCardsTotal = &yellow
-		teamStats.RedCardsTotal = &red
-
-		for _, l := range resp.Lineup {
-			lineup := &model.Lineup{
-				Season: 	resp.League.Season,
-				Formation: 	l.Formation,
-				Played: 	l.Played,
-			}
-			s.db.Create(&lineup)
-		}
-
-		s.db.Create(&teamStats)
+func (s *service) SaveTeamStats(ctx context.Context, teamID int, seasons []int) ([]*model.TeamStatsResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.client.FetchTeamStats(ctx, team.TeamID, team.LeagueID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.importInTx(ctx, "team stats", func(tx *gorm.DB) error {
+		for _, seasonStats := range stats {
+			if seasonStats == nil {
+				continue
+			}
+
+			resp := seasonStats.Response
+
+			teamStats := &model.TeamStats{
+				TeamID:     resp.Team.ID,
+				TeamName:   resp.Team.Name,
+				LeagueID:   resp.League.TeamID,
+				LeagueName: resp.League.Name,
+				Country:    resp.League.Country,
+				Season:     resp.League.Season,
+				Form:       resp.Form,
+
+				PlayedHome:  resp.Fixtures.Played.Home,
+				PlayedAway:  resp.Fixtures.Played.Away,
+				PlayedTotal: resp.Fixtures.Played.Total,
+				WinsHome:    resp.Fixtures.Wins.Home,
+				WinsAway:    resp.Fixtures.Wins.Away,
+				WinsTotal:   resp.Fixtures.Wins.Total,
+				DrawsHome:   resp.Fixtures.Draws.Home,
+				DrawsAway:   resp.Fixtures.Draws.Away,
+				DrawsTotal:  resp.Fixtures.Draws.Total,
+				LosesHome:   resp.Fixtures.Loses.Home,
+				LosesAway:   resp.Fixtures.Loses.Away,
+				LosesTotal:  resp.Fixtures.Loses.Total,
+
+				GoalsForHome:      resp.Goals.For.Total.Home,
+				GoalsForAway:      resp.Goals.For.Total.Away,
+				GoalsForTotal:     resp.Goals.For.Total.Total,
+				GoalsAgainstHome:  resp.Goals.Against.Total.Home,
+				GoalsAgainstAway:  resp.Goals.Against.Total.Away,
+				GoalsAgainstTotal: resp.Goals.Against.Total.Total,
+
+				GoalsForAvgHome:      resp.Goals.For.Average.Home,
+				GoalsForAvgAway:      resp.Goals.For.Average.Away,
+				GoalsForAvgTotal:     resp.Goals.For.Average.Total,
+				GoalsAgainstAvgHome:  resp.Goals.Against.Average.Home,
+				GoalsAgainstAvgAway:  resp.Goals.Against.Average.Away,
+				GoalsAgainstAvgTotal: resp.Goals.Against.Average.Total,
+
+				StreakWins:             resp.Biggest.Streak.Wins,
+				StreakDraws:            resp.Biggest.Streak.Draws,
+				StreakLoses:            resp.Biggest.Streak.Loses,
+				BiggestWinHome:         resp.Biggest.Wins.Home,
+				BiggestWinAway:         resp.Biggest.Wins.Away,
+				BiggestLoseHome:        resp.Biggest.Loses.Home,
+				BiggestLoseAway:        resp.Biggest.Loses.Away,
+				BiggestGoalsForHome:    resp.Biggest.Goals.For.Home,
+				BiggestGoalsForAway:    resp.Biggest.Goals.For.Away,
+				BiggestGoalsAgainstHome: resp.Biggest.Goals.Against.Home,
+				BiggestGoalsAgainstAway: resp.Biggest.Goals.Against.Away,
+
+				CleanSheetHome:     resp.CleanSheet.Home,
+				CleanSheetAway:     resp.CleanSheet.Away,
+				CleanSheetTotal:    resp.CleanSheet.Total,
+				FailedToScoreHome:  resp.FailedToScore.Home,
+				FailedToScoreAway:  resp.FailedToScore.Away,
+				FailedToScoreTotal: resp.FailedToScore.Total,
+
+				PenaltyScoredTotal: resp.Penalty.Scored.Total,
+				PenaltyScoredPct:   resp.Penalty.Scored.Percentage,
+				PenaltyMissedTotal: resp.Penalty.Missed.Total,
+				PenaltyMissedPct:   resp.Penalty.Missed.Percentage,
+				PenaltyTotal:       resp.Penalty.Total,
+			}
+
+			yellow := 0
+			red := 0
+
+			for _, minuteRange := range cardBuckets(resp.Cards.Yellow) {
+				if minuteRange.Total != nil {
+					yellow += *minuteRange.Total
+				}
+			}
+
+			for _, minuteRange := range cardBuckets(resp.Cards.Red) {
+				if minuteRange.Total != nil {
+					red += *minuteRange.Total
+				}
+			}
+
+			teamStats.YellowCardsTotal = &yellow
+			teamStats.RedCardsTotal = &red
+
+			for _, l := range resp.Lineup {
+				lineup := &model.Lineup{
+					TeamID: 	resp.Team.ID,
+					Season: 	resp.League.Season,
+					Formation: 	l.Formation,
+					Played: 	l.Played,
+				}
+				if err := upsert(tx, lineup, "team_id", "season", "formation").Error; err != nil {
+					return fmt.Errorf("lineup %s season %d: %w", lineup.Formation, lineup.Season, err)
+				}
+			}
+
+			goalsMinutes := append(
+				goalsMinuteRows(resp.Team.ID, resp.League.Season, model.GoalsSideFor, resp.Goals.For.Minute),
+				goalsMinuteRows(resp.Team.ID, resp.League.Season, model.GoalsSideAgainst, resp.Goals.Against.Minute)...,
+			)
+			if len(goalsMinutes) > 0 {
+				if err := upsert(tx, &goalsMinutes, "team_id", "season", "side", "minute").Error; err != nil {
+					return fmt.Errorf("goals by minute season %d: %w", resp.League.Season, err)
+				}
+			}
+
+			underOver := append(
+				underOverRows(resp.Team.ID, resp.League.Season, model.GoalsSideFor, resp.Goals.For.UnderOver),
+				underOverRows(resp.Team.ID, resp.League.Season, model.GoalsSideAgainst, resp.Goals.Against.UnderOver)...,
+			)
+			if len(underOver) > 0 {
+				if err := upsert(tx, &underOver, "team_id", "season", "side", "line").Error; err != nil {
+					return fmt.Errorf("goals under/over season %d: %w", resp.League.Season, err)
+				}
+			}
+
+			cardsMinutes := append(
+				cardsMinuteRows(resp.Team.ID, resp.League.Season, model.CardColorYellow, resp.Cards.Yellow),
+				cardsMinuteRows(resp.Team.ID, resp.League.Season, model.CardColorRed, resp.Cards.Red)...,
+			)
+			if err := upsert(tx, &cardsMinutes, "team_id", "season", "color", "minute").Error; err != nil {
+				return fmt.Errorf("cards by minute season %d: %w", resp.League.Season, err)
+			}
+
+			if err := upsert(tx, teamStats, "team_id", "league_id", "season").Error; err != nil {
+				return fmt.Errorf("team stats season %d: %w", teamStats.Season, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return stats, nil
 }
 
 
-func (s *service) SaveVenues() (*model.VenueResponse, error) {
-	venues, err := s.client.FetchVenues()
+func (s *service) SaveVenues(ctx context.Context, teamID int) (*model.VenueResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	venues, err := s.client.FetchVenues(ctx, team.Country)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, v := range venues.Response {
-		venue := &model.Venue{
-			VenueID: 	v.VenueID,
-			VenueName: 	v.VenueName,
-			Address: 	v.Address,
-			City: 		v.City,
-			Capacity: 	v.Capacity,
-			Surface: 	v.Surface,
+	err = s.importInTx(ctx, "venues", func(tx *gorm.DB) error {
+		for _, v := range venues.Response {
+			venue := &model.Venue{
+				VenueID: 	v.VenueID,
+				VenueName: 	v.VenueName,
+				Address: 	v.Address,
+				City: 		v.City,
+				Capacity: 	v.Capacity,
+				Surface: 	v.Surface,
+			}
+			if err := upsert(tx, venue, "venue_id").Error; err != nil {
+				return fmt.Errorf("venue %d: %w", venue.VenueID, err)
+			}
 		}
-		s.db.Create(&venue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return venues, nil
 }
 
 
-func (s *service) SaveStandings() ([]*model.StandingResponse, error) {
-	standings, err := s.client.FetchStandings(39, 33)
+func (s *service) SaveStandings(ctx context.Context, teamID int, seasons []int) ([]*model.StandingResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, response := range standings {
-		for _, standingDTO := range response.Response {
-			info := standingDTO.StandingInfo
+	standings, err := s.client.FetchStandings(ctx, team.LeagueID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
 
-			for _, group := range info.Standings {
-				for _, entry := range group {
-					record := model.Standing{
-						LeagueID:    info.ID,
-						LeagueName:  info.Name,
-						Country:     info.Country,
-						Season:      info.Season,
+	err = s.importInTx(ctx, "standings", func(tx *gorm.DB) error {
+		for _, response := range standings {
+			for _, standingDTO := range response.Response {
+				info := standingDTO.StandingInfo
+
+				for _, group := range info.Standings {
+					for _, entry := range group {
+						record := model.Standing{
+							LeagueID:    info.ID,
+							LeagueName:  info.Name,
+							Country:     info.Country,
+							Season:      info.Season,
+
+							TeamID:      entry.Team.ID,
+							TeamName:    entry.Team.Name,
+
+							Rank:        entry.Rank,
+							Points:      entry.Points,
+							GoalsDiff:   entry.GoalsDiff,
+							GroupName:   entry.Group,
+							Form:        entry.Form,
+							Status:      entry.Status,
+							Description: entry.Description,
+
+							PlayedAll:       entry.All.Played,
+							WinsAll:         entry.All.Win,
+							DrawsAll:        entry.All.Draw,
+							LosesAll:        entry.All.Lose,
+							GoalsForAll:     entry.All.Goals.For,
+							GoalsAgainstAll: entry.All.Goals.Against,
+
+							PlayedHome:       entry.Home.Played,
+							WinsHome:         entry.Home.Win,
+							DrawsHome:        entry.Home.Draw,
+							LosesHome:        entry.Home.Lose,
+							GoalsForHome:     entry.Home.Goals.For,
+							GoalsAgainstHome: entry.Home.Goals.Against,
+
+							PlayedAway:       entry.Away.Played,
+							WinsAway:         entry.Away.Win,
+							DrawsAway:        entry.Away.Draw,
+							LosesAway:        entry.Away.Lose,
+							GoalsForAway:     entry.Away.Goals.For,
+							GoalsAgainstAway: entry.Away.Goals.Against,
+
+							UpdatedAt: entry.Update,
+						}
+						if err := upsert(tx, &record, "league_id", "season", "team_id").Error; err != nil {
+							return fmt.Errorf("standing team %d season %d: %w", record.TeamID, record.Season, err)
+						}
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-						TeamID:      entry.Team.ID,
-						TeamName:    entry.Team.Name,
+	return standings, nil
+}
 
-						Rank:        entry.Rank,
-						Points:      entry.Points,
-						GoalsDiff:   entry.GoalsDiff,
-						GroupName:   entry.Group,
-						Form:        entry.Form,
-						Status:      entry.Status,
-						Description: entry.Description,
 
-						PlayedAll:       entry.All.Played,
-						WinsAll:         entry.All.Win,
-						DrawsAll:        entry.All.Draw,
-						LosesAll:        entry.All.Lose,
-						GoalsForAll:     entry.All.Goals.For,
-						GoalsAgainstAll: entry.All.Goals.Against,
+func (s *service) SaveFixtures(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
 
-						PlayedHome:       entry.Home.Played,
-						WinsHome:         entry.Home.Win,
-						DrawsHome:        entry.Home.Draw,
-						LosesHome:        entry.Home.Lose,
-						GoalsForHome:     entry.Home.Goals.For,
-						GoalsAgainstHome: entry.Home.Goals.Against,
+	fixtures, err := s.client.FetchFixtures(ctx, team.LeagueID, team.TeamID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
 
-						PlayedAway:       entry.Away.Played,
-						WinsAway:         entry.Away.Win,
-						DrawsAway:        entry.Away.Draw,
-						LosesAway:        entry.Away.Lose,
-						GoalsForAway:     entry.Away.Goals.For,
-						GoalsAgainstAway: entry.Away.Goals.Against,
+	var allFixtures []model.Fixture
 
-						UpdatedAt: entry.Update,
-					}
-					s.db.Create(&record)
-				}
-			}
+	for _, seasonResp := range fixtures {
+		for _, dto := range seasonResp.Response {
+			allFixtures = append(allFixtures, fixtureRow(dto))
 		}
 	}
-	
-	return standings, nil
+
+	err = s.importInTx(ctx, "fixtures", func(tx *gorm.DB) error {
+		if len(allFixtures) == 0 {
+			return nil
+		}
+		return upsert(tx, &allFixtures, "fixture_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fixtures, nil
 }
 
 
-func (s *service) SaveFixtures() ([]*model.FixtureResponse, error) {
-	fixtures, err := s.client.FetchFixtures(39, 33) 
+func (s *service) SaveLeagueFixtures(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := s.client.FetchLeagueFixtures(ctx, team.LeagueID, resolveSeasons(seasons))
 	if err != nil {
 		return nil, err
 	}
@@ -313,67 +477,31 @@ func (s *service) SaveFixtures() ([]*model.FixtureResponse, error) {
 
 	for _, seasonResp := range fixtures {
 		for _, dto := range seasonResp.Response {
-			fixture := dto.Fixture
-			league 	:= dto.League
-			teams 	:= dto.Teams
-			goals 	:= dto.Goals
-			score 	:= dto.Score
-
-			allFixtures = append(allFixtures, model.Fixture{
-				FixtureID:  	fixture.ID,
-				Referee:    	fixture.Referee,
-				Timezone:   	fixture.Timezone,
-				Date:       	fixture.Date,
-				Timestamp:  	fixture.Timestamp,
-				PeriodFirst:  	fixture.Periods.First,
-				PeriodSecond: 	fixture.Periods.Second,
-				VenueID:     	fixture.Venue.ID,
-				VenueName:   	fixture.Venue.Name,
-				VenueCity:   	fixture.Venue.City,
-				StatusLong:   	fixture.Status.Long,
-				StatusShort:  	fixture.Status.Short,
-				StatusElapsed: 	fixture.Status.Elapsed,
-				StatusExtra:   	fixture.Status.Extra,
-
-				LeagueID:   league.ID,
-				LeagueName: league.Name,
-				Country:    league.Country,
-				Season:     league.Season,
-				Round:      league.Round,
-				Standings:  league.Standings,
-
-				HomeTeamID:   teams.Home.ID,
-				HomeTeamName: teams.Home.Name,
-				HomeTeamLogo: teams.Home.Logo,
-				HomeWinner:   teams.Home.Winner,
-				AwayTeamID:   teams.Away.ID,
-				AwayTeamName: teams.Away.Name,
-				AwayTeamLogo: teams.Away.Logo,
-				AwayWinner:   teams.Away.Winner,
-
-				GoalsHome:  safeInt(goals.Home),
-				GoalsAway:  safeInt(goals.Away),
-
-				HalftimeHome:  score.Halftime.Home,
-				HalftimeAway:  score.Halftime.Away,
-				FulltimeHome:  score.Fulltime.Home,
-				FulltimeAway:  score.Fulltime.Away,
-				ExtratimeHome: score.Extratime.Home,
-				ExtratimeAway: score.Extratime.Away,
-				PenaltyHome:   score.Penalty.Home,
-				PenaltyAway:   score.Penalty.Away,
-			})
+			allFixtures = append(allFixtures, fixtureRow(dto))
 		}
 	}
 
-	s.db.Create(&allFixtures)
+	err = s.importInTx(ctx, "league fixtures", func(tx *gorm.DB) error {
+		if len(allFixtures) == 0 {
+			return nil
+		}
+		return upsert(tx, &allFixtures, "fixture_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return fixtures, nil
 }
 
 
-func (s *service) SaveInjuries() ([]*model.InjuryResponse, error) {
-	injuriesResp, err := s.client.FetchInjuries(33)
+func (s *service) SaveInjuries(ctx context.Context, teamID int, seasons []int) ([]*model.InjuryResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	injuriesResp, err := s.client.FetchInjuries(ctx, team.TeamID, resolveSeasons(seasons))
 	if err != nil {
 		return nil, err
 	}
@@ -410,14 +538,27 @@ func (s *service) SaveInjuries() ([]*model.InjuryResponse, error) {
 		}
 	}
 
-	s.db.Create(&injuries)
+	err = s.importInTx(ctx, "injuries", func(tx *gorm.DB) error {
+		if len(injuries) == 0 {
+			return nil
+		}
+		return upsert(tx, &injuries, "player_id", "fixture_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return injuriesResp, nil
 }
 
 
-func (s *service) SaveSquad() (*model.SquadResponse, error) {
-	squad, err := s.client.FetchSquad(33)
+func (s *service) SaveSquad(ctx context.Context, teamID int) (*model.SquadResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	squad, err := s.client.FetchSquad(ctx, team.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -441,7 +582,642 @@ func (s *service) SaveSquad() (*model.SquadResponse, error) {
 		}
 	}
 
-	s.db.Create(&squadEntries)
+	err = s.importInTx(ctx, "squad", func(tx *gorm.DB) error {
+		if len(squadEntries) == 0 {
+			return nil
+		}
+		return upsert(tx, &squadEntries, "player_id", "team_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return squad, nil
-}
\ No newline at end of file
+}
+
+
+func (s *service) SaveFixtureEvents(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureEventResponse, error) {
+	fixtureIDs, err := s.storedFixtureIDs(ctx, teamID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
+
+	eventsResp, err := s.client.FetchFixtureEvents(ctx, fixtureIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.importInTx(ctx, "fixture events", func(tx *gorm.DB) error {
+		for i, fixtureEvents := range eventsResp {
+			fixtureID := fixtureIDs[i]
+
+			var events []model.FixtureEvent
+			for sequence, e := range fixtureEvents.Response {
+				events = append(events, model.FixtureEvent{
+					FixtureID: 		fixtureID,
+					Sequence: 		sequence,
+					Elapsed: 		e.Time.Elapsed,
+					Extra: 			e.Time.Extra,
+					TeamID: 		e.Team.ID,
+					TeamName: 		e.Team.Name,
+					TeamLogo: 		e.Team.Logo,
+					PlayerID: 		e.Player.ID,
+					PlayerName: 	safeString(e.Player.Name),
+					AssistID: 		e.Assist.ID,
+					AssistName: 	safeString(e.Assist.Name),
+					Type: 			e.Type,
+					Detail: 		e.Detail,
+					Comments: 		safeString(e.Comments),
+				})
+			}
+
+			// API-Football can correct a timeline after the match, so events past
+			// the new end of the timeline are dropped rather than left stale.
+			if err := tx.Where("fixture_id = ? AND sequence >= ?", fixtureID, len(events)).Delete(&model.FixtureEvent{}).Error; err != nil {
+				return fmt.Errorf("fixture %d events: %w", fixtureID, err)
+			}
+
+			if len(events) == 0 {
+				continue
+			}
+			if err := upsert(tx, &events, "fixture_id", "sequence").Error; err != nil {
+				return fmt.Errorf("fixture %d events: %w", fixtureID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsResp, nil
+}
+
+
+func (s *service) SaveFixtureLineups(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureLineupResponse, error) {
+	fixtureIDs, err := s.storedFixtureIDs(ctx, teamID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
+
+	lineupsResp, err := s.client.FetchFixtureLineups(ctx, fixtureIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.importInTx(ctx, "fixture lineups", func(tx *gorm.DB) error {
+		for i, fixtureLineups := range lineupsResp {
+			fixtureID := fixtureIDs[i]
+
+			var players []model.FixtureLineupPlayer
+			var teamIDs, playerIDs []int
+			seen := make(map[int]bool)
+
+			for _, dto := range fixtureLineups.Response {
+				lineup := &model.FixtureLineup{
+					FixtureID: 	fixtureID,
+					TeamID: 	dto.Team.ID,
+					TeamName: 	dto.Team.Name,
+					TeamLogo: 	dto.Team.Logo,
+					Formation: 	dto.Formation,
+					CoachID: 	dto.Coach.ID,
+					CoachName: 	safeString(dto.Coach.Name),
+				}
+				if err := upsert(tx, lineup, "fixture_id", "team_id").Error; err != nil {
+					return fmt.Errorf("fixture %d lineup team %d: %w", fixtureID, lineup.TeamID, err)
+				}
+				teamIDs = append(teamIDs, dto.Team.ID)
+
+				for _, group := range []struct {
+					entries	[]model.FixtureLineupEntry
+					starter	bool
+				}{{dto.StartXI, true}, {dto.Substitutes, false}} {
+					for _, entry := range group.entries {
+						// Unknown players come back with a null id and a player can be
+						// listed twice, both would hit (fixture_id, player_id) twice.
+						if entry.Player.ID == 0 || seen[entry.Player.ID] {
+							continue
+						}
+						seen[entry.Player.ID] = true
+
+						players = append(players, model.FixtureLineupPlayer{
+							FixtureID: 		fixtureID,
+							TeamID: 		dto.Team.ID,
+							PlayerID: 		entry.Player.ID,
+							PlayerName: 	entry.Player.Name,
+							Number: 		entry.Player.Number,
+							Position: 		entry.Player.Pos,
+							Grid: 			safeString(entry.Player.Grid),
+							Starter: 		group.starter,
+						})
+						playerIDs = append(playerIDs, entry.Player.ID)
+					}
+				}
+			}
+
+			// A corrected or withdrawn lineup replaces the stored one, including
+			// teams and players the provider no longer lists.
+			staleTeams := tx.Where("fixture_id = ?", fixtureID)
+			if len(teamIDs) > 0 {
+				staleTeams = staleTeams.Where("team_id NOT IN ?", teamIDs)
+			}
+			if err := staleTeams.Delete(&model.FixtureLineup{}).Error; err != nil {
+				return fmt.Errorf("fixture %d lineups: %w", fixtureID, err)
+			}
+
+			stalePlayers := tx.Where("fixture_id = ?", fixtureID)
+			if len(playerIDs) > 0 {
+				stalePlayers = stalePlayers.Where("player_id NOT IN ?", playerIDs)
+			}
+			if err := stalePlayers.Delete(&model.FixtureLineupPlayer{}).Error; err != nil {
+				return fmt.Errorf("fixture %d lineup players: %w", fixtureID, err)
+			}
+
+			if len(players) == 0 {
+				continue
+			}
+			if err := upsert(tx, &players, "fixture_id", "player_id").Error; err != nil {
+				return fmt.Errorf("fixture %d lineup players: %w", fixtureID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lineupsResp, nil
+}
+
+
+func (s *service) SaveFixtureStatistics(ctx context.Context, teamID int, seasons []int) ([]*model.FixtureStatisticsResponse, error) {
+	fixtureIDs, err := s.storedFixtureIDs(ctx, teamID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
+
+	statisticsResp, err := s.client.FetchFixtureStatistics(ctx, fixtureIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var statistics []model.FixtureStatistics
+
+	for i, fixtureStatistics := range statisticsResp {
+		for _, dto := range fixtureStatistics.Response {
+			record := model.FixtureStatistics{
+				FixtureID: 	fixtureIDs[i],
+				TeamID: 	dto.Team.ID,
+				TeamName: 	dto.Team.Name,
+			}
+
+			for _, stat := range dto.Statistics {
+				switch stat.Type {
+				case "Shots on Goal":
+					record.ShotsOnGoal = statInt(stat.Value)
+				case "Shots off Goal":
+					record.ShotsOffGoal = statInt(stat.Value)
+				case "Total Shots":
+					record.TotalShots = statInt(stat.Value)
+				case "Blocked Shots":
+					record.BlockedShots = statInt(stat.Value)
+				case "Shots insidebox":
+					record.ShotsInsideBox = statInt(stat.Value)
+				case "Shots outsidebox":
+					record.ShotsOutsideBox = statInt(stat.Value)
+				case "Fouls":
+					record.Fouls = statInt(stat.Value)
+				case "Corner Kicks":
+					record.CornerKicks = statInt(stat.Value)
+				case "Offsides":
+					record.Offsides = statInt(stat.Value)
+				case "Ball Possession":
+					record.BallPossession = statInt(stat.Value)
+				case "Yellow Cards":
+					record.YellowCards = statInt(stat.Value)
+				case "Red Cards":
+					record.RedCards = statInt(stat.Value)
+				case "Goalkeeper Saves":
+					record.GoalkeeperSaves = statInt(stat.Value)
+				case "Total passes":
+					record.TotalPasses = statInt(stat.Value)
+				case "Passes accurate":
+					record.PassesAccurate = statInt(stat.Value)
+				case "Passes %":
+					record.PassesPct = statInt(stat.Value)
+				case "expected_goals":
+					record.ExpectedGoals = statFloat(stat.Value)
+				}
+			}
+
+			statistics = append(statistics, record)
+		}
+	}
+
+	err = s.importInTx(ctx, "fixture statistics", func(tx *gorm.DB) error {
+		if len(statistics) == 0 {
+			return nil
+		}
+		return upsert(tx, &statistics, "fixture_id", "team_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statisticsResp, nil
+}
+
+
+func (s *service) SavePlayerStats(ctx context.Context, teamID int, seasons []int) ([]*model.PlayerStatsResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	playersResp, err := s.client.FetchPlayerStats(ctx, team.TeamID, resolveSeasons(seasons))
+	if err != nil {
+		return nil, err
+	}
+
+	players := make(map[int]model.Player)
+	var stats []model.PlayerSeasonStats
+
+	for _, seasonResp := range playersResp {
+		for _, dto := range seasonResp.Response {
+			p := dto.Player
+			players[p.ID] = model.Player{
+				PlayerID: 		p.ID,
+				Name: 			p.Name,
+				Firstname: 		p.Firstname,
+				Lastname: 		p.Lastname,
+				Age: 			safeInt(p.Age),
+				BirthDate: 		safeString(p.Birth.Date),
+				BirthPlace: 	safeString(p.Birth.Place),
+				BirthCountry: 	safeString(p.Birth.Country),
+				Nationality: 	safeString(p.Nationality),
+				Height: 		safeString(p.Height),
+				Weight: 		safeString(p.Weight),
+				Photo: 			p.Photo,
+			}
+
+			for _, st := range dto.Statistics {
+				stats = append(stats, model.PlayerSeasonStats{
+					PlayerID: 			p.ID,
+					PlayerName: 		p.Name,
+					TeamID: 			st.Team.ID,
+					TeamName: 			st.Team.Name,
+					LeagueID: 			st.League.ID,
+					LeagueName: 		st.League.Name,
+					Season: 			st.League.Season,
+
+					Appearances: 		safeInt(st.Games.Appearences),
+					Lineups: 			safeInt(st.Games.Lineups),
+					Minutes: 			safeInt(st.Games.Minutes),
+					Number: 			st.Games.Number,
+					Position: 			st.Games.Position,
+					Rating: 			statFloat(safeString(st.Games.Rating)),
+					Captain: 			st.Games.Captain,
+					SubstitutesIn: 		safeInt(st.Substitutes.In),
+					SubstitutesOut: 	safeInt(st.Substitutes.Out),
+					Bench: 				safeInt(st.Substitutes.Bench),
+
+					ShotsTotal: 		safeInt(st.Shots.Total),
+					ShotsOn: 			safeInt(st.Shots.On),
+					Goals: 				safeInt(st.Goals.Total),
+					GoalsConceded: 		safeInt(st.Goals.Conceded),
+					Assists: 			safeInt(st.Goals.Assists),
+					Saves: 				safeInt(st.Goals.Saves),
+
+					PassesTotal: 		safeInt(st.Passes.Total),
+					PassesKey: 			safeInt(st.Passes.Key),
+					PassesAccuracy: 	safeInt(st.Passes.Accuracy),
+
+					Tackles: 			safeInt(st.Tackles.Total),
+					Blocks: 			safeInt(st.Tackles.Blocks),
+					Interceptions: 		safeInt(st.Tackles.Interceptions),
+					DuelsTotal: 		safeInt(st.Duels.Total),
+					DuelsWon: 			safeInt(st.Duels.Won),
+					DribblesAttempts: 	safeInt(st.Dribbles.Attempts),
+					DribblesSuccess: 	safeInt(st.Dribbles.Success),
+					FoulsDrawn: 		safeInt(st.Fouls.Drawn),
+					FoulsCommitted: 	safeInt(st.Fouls.Committed),
+
+					YellowCards: 		safeInt(st.Cards.Yellow),
+					YellowRedCards: 	safeInt(st.Cards.YellowRed),
+					RedCards: 			safeInt(st.Cards.Red),
+
+					PenaltyWon: 		safeInt(st.Penalty.Won),
+					PenaltyCommitted: 	safeInt(st.Penalty.Commited),
+					PenaltyScored: 		safeInt(st.Penalty.Scored),
+					PenaltyMissed: 		safeInt(st.Penalty.Missed),
+					PenaltySaved: 		safeInt(st.Penalty.Saved),
+				})
+			}
+		}
+	}
+
+	err = s.importInTx(ctx, "player stats", func(tx *gorm.DB) error {
+		for _, player := range players {
+			if err := upsert(tx, &player, "player_id").Error; err != nil {
+				return fmt.Errorf("player %d: %w", player.PlayerID, err)
+			}
+		}
+
+		if len(stats) == 0 {
+			return nil
+		}
+		return upsert(tx, &stats, "player_id", "team_id", "league_id", "season").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return playersResp, nil
+}
+
+
+func (s *service) SaveLeaderboards(ctx context.Context, teamID int, seasons []int) (map[string][]*model.PlayerStatsResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons = resolveSeasons(seasons)
+
+	fetchers := map[string]func(context.Context, int, []int) ([]*model.PlayerStatsResponse, error){
+		model.LeaderboardScorers: 		s.client.FetchTopScorers,
+		model.LeaderboardAssists: 		s.client.FetchTopAssists,
+		model.LeaderboardYellowCards: 	s.client.FetchTopYellowCards,
+		model.LeaderboardRedCards: 		s.client.FetchTopRedCards,
+	}
+
+	leaderboards := make(map[string][]*model.PlayerStatsResponse, len(fetchers))
+	for _, metric := range model.LeaderboardMetrics {
+		resp, err := fetchers[metric](ctx, team.LeagueID, seasons)
+		if err != nil {
+			return nil, fmt.Errorf("%s leaderboard: %w", metric, err)
+		}
+		leaderboards[metric] = resp
+	}
+
+	err = s.importInTx(ctx, "leaderboards", func(tx *gorm.DB) error {
+		for _, metric := range model.LeaderboardMetrics {
+			for i, seasonResp := range leaderboards[metric] {
+				season := seasons[i]
+
+				entries := leaderboardEntries(team.LeagueID, season, metric, seasonResp.Response)
+
+				if err := tx.Where("league_id = ? AND season = ? AND metric = ? AND rank > ?", team.LeagueID, season, metric, len(entries)).Delete(&model.LeaderboardEntry{}).Error; err != nil {
+					return fmt.Errorf("%s leaderboard season %d: %w", metric, season, err)
+				}
+
+				if len(entries) == 0 {
+					continue
+				}
+				if err := upsert(tx, &entries, "league_id", "season", "metric", "rank").Error; err != nil {
+					return fmt.Errorf("%s leaderboard season %d: %w", metric, season, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return leaderboards, nil
+}
+
+
+func (s *service) SaveTransfers(ctx context.Context, teamID int) (*model.TransferResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfersResp, err := s.client.FetchTransfers(ctx, team.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	type transferKey struct {
+		playerID, teamInID, teamOutID	int
+		date							string
+	}
+
+	// The same move can be listed twice for a player, and postgres rejects an
+	// upsert batch that touches one row more than once.
+	seen := make(map[transferKey]bool)
+	var transfers []model.Transfer
+
+	for _, dto := range transfersResp.Response {
+		for _, t := range dto.Transfers {
+			key := transferKey{dto.Player.ID, t.Teams.In.ID, t.Teams.Out.ID, t.Date}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			transfers = append(transfers, model.Transfer{
+				PlayerID: 		dto.Player.ID,
+				PlayerName: 	dto.Player.Name,
+				Date: 			t.Date,
+				Type: 			safeString(t.Type),
+				TeamInID: 		t.Teams.In.ID,
+				TeamInName: 	t.Teams.In.Name,
+				TeamInLogo: 	t.Teams.In.Logo,
+				TeamOutID: 		t.Teams.Out.ID,
+				TeamOutName: 	t.Teams.Out.Name,
+				TeamOutLogo: 	t.Teams.Out.Logo,
+			})
+		}
+	}
+
+	err = s.importInTx(ctx, "transfers", func(tx *gorm.DB) error {
+		if len(transfers) == 0 {
+			return nil
+		}
+		return upsert(tx, &transfers, "player_id", "date", "team_in_id", "team_out_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfersResp, nil
+}
+
+
+func (s *service) SaveCoaches(ctx context.Context, teamID int) (*model.CoachResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	coachesResp, err := s.client.FetchCoaches(ctx, team.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	coaches, careers := coachRows(coachesResp.Response)
+
+	err = s.importInTx(ctx, "coaches", func(tx *gorm.DB) error {
+		if len(coaches) == 0 {
+			return nil
+		}
+		if err := upsert(tx, &coaches, "coach_id").Error; err != nil {
+			return err
+		}
+
+		if len(careers) == 0 {
+			return nil
+		}
+		return upsert(tx, &careers, "coach_id", "team_id", "start").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coachesResp, nil
+}
+
+
+func (s *service) SavePlayerTrophies(ctx context.Context, teamID int) ([]*model.TrophyResponse, error) {
+	playerIDs, err := s.storedPlayerIDs(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	trophiesResp, err := s.client.FetchPlayerTrophies(ctx, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	trophies := trophyRows(trophiesResp, playerIDs, false)
+
+	err = s.importInTx(ctx, "player trophies", func(tx *gorm.DB) error {
+		if len(trophies) == 0 {
+			return nil
+		}
+		return upsert(tx, &trophies, "player_id", "coach_id", "league", "country", "season").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trophiesResp, nil
+}
+
+
+func (s *service) SaveCoachTrophies(ctx context.Context, teamID int) ([]*model.TrophyResponse, error) {
+	coachIDs, err := s.storedCoachIDs(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	trophiesResp, err := s.client.FetchCoachTrophies(ctx, coachIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	trophies := trophyRows(trophiesResp, coachIDs, true)
+
+	err = s.importInTx(ctx, "coach trophies", func(tx *gorm.DB) error {
+		if len(trophies) == 0 {
+			return nil
+		}
+		return upsert(tx, &trophies, "player_id", "coach_id", "league", "country", "season").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trophiesResp, nil
+}
+
+
+func (s *service) SaveSidelined(ctx context.Context, teamID int) ([]*model.SidelinedResponse, error) {
+	playerIDs, err := s.storedPlayerIDs(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	sidelinedResp, err := s.client.FetchSidelined(ctx, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.importInTx(ctx, "sidelined", func(tx *gorm.DB) error {
+		for i, playerSidelined := range sidelinedResp {
+			playerID := playerIDs[i]
+
+			seen := make(map[model.SidelinedDTO]bool)
+			var periods []model.Sidelined
+			for _, dto := range playerSidelined.Response {
+				key := model.SidelinedDTO{Type: dto.Type, Start: dto.Start}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				periods = append(periods, model.Sidelined{
+					PlayerID: 	playerID,
+					Type: 		dto.Type,
+					Start: 		dto.Start,
+					End: 		safeString(dto.End),
+				})
+			}
+
+			// a period whose start date API-Football has since corrected would
+			// otherwise linger next to its replacement
+			if err := tx.Where("player_id = ?", playerID).Delete(&model.Sidelined{}).Error; err != nil {
+				return fmt.Errorf("player %d sidelined: %w", playerID, err)
+			}
+
+			if len(periods) == 0 {
+				continue
+			}
+			if err := upsert(tx, &periods, "player_id", "type", "start").Error; err != nil {
+				return fmt.Errorf("player %d sidelined: %w", playerID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sidelinedResp, nil
+}
+
+
+// SaveHeadToHead stores every meeting with an opponent, cup ties and seasons
+// outside the tracked league included, in their own table so readers of the
+// league fixtures never see them.
+func (s *service) SaveHeadToHead(ctx context.Context, teamID, opponentID int) (*model.FixtureResponse, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := s.client.FetchHeadToHead(ctx, team.TeamID, opponentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []model.HeadToHeadMeeting
+	for _, dto := range meetings.Response {
+		rows = append(rows, model.HeadToHeadMeeting(fixtureRow(dto)))
+	}
+
+	err = s.importInTx(ctx, "head to head", func(tx *gorm.DB) error {
+		if len(rows) == 0 {
+			return nil
+		}
+		return upsert(tx, &rows, "fixture_id").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return meetings, nil
+}
@@ -0,0 +1,311 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/deikioveca/TheRedDevilsData/api/database"
+	"github.com/deikioveca/TheRedDevilsData/api/football_client"
+	"github.com/deikioveca/TheRedDevilsData/api/mock_provider"
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+	"github.com/deikioveca/TheRedDevilsData/api/service"
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+
+// openTestDB runs the importers against TEST_DATABASE_DSN when it is set, so
+// the upserts can be checked on Postgres, and against in-memory SQLite otherwise.
+// The tables a test imports into are cleared along with the tracked teams.
+func openTestDB(t *testing.T, tables ...string) *gorm.DB {
+	t.Helper()
+
+	dialector := sqlite.Open("file::memory:")
+	if dsn := os.Getenv("TEST_DATABASE_DSN"); dsn != "" {
+		dialector = postgres.Open(dsn)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	for _, table := range append(tables, "tracked_teams") {
+		if err := db.Exec("DELETE FROM " + table).Error; err != nil {
+			t.Fatalf("failed to clear %s: %v", table, err)
+		}
+	}
+
+	defaultTeam := model.DefaultTrackedTeam
+	if err := db.Create(&defaultTeam).Error; err != nil {
+		t.Fatalf("failed to seed default tracked team: %v", err)
+	}
+
+	return db
+}
+
+
+// requestCounter counts the provider requests per path and season, after the
+// mock provider has cleaned the path the client joined onto BASE_URL.
+type requestCounter struct {
+	mu		sync.Mutex
+	counts	map[string]int
+}
+
+
+func (c *requestCounter) count(path, season string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[path+"?season="+season]
+}
+
+
+func newTestService(t *testing.T, db *gorm.DB) (service.Service, *requestCounter) {
+	t.Helper()
+
+	counter := &requestCounter{counts: make(map[string]int)}
+	provider := mock_provider.NewServer(1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provider.ServeHTTP(w, r)
+
+		counter.mu.Lock()
+		counter.counts[r.URL.Path+"?season="+r.URL.Query().Get("season")]++
+		counter.mu.Unlock()
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Setenv("API_KEY", "test")
+	t.Setenv("BASE_URL", srv.URL)
+	t.Setenv("API_RATE_LIMIT_PER_MINUTE", "6000")
+	t.Setenv("FOOTBALL_CASSETTE_MODE", "")
+
+	client, err := football_client.NewFootballClient(db)
+	if err != nil {
+		t.Fatalf("NewFootballClient() error = %v", err)
+	}
+
+	return service.NewService(db, client), counter
+}
+
+
+func TestSaveFixtures(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, "fixtures")
+	svc, counter := newTestService(t, db)
+
+	responses, err := svc.SaveFixtures(ctx, 0, []int{2023, 2022, 2023})
+	if err != nil {
+		t.Fatalf("SaveFixtures() error = %v", err)
+	}
+
+	for _, season := range []string{"2022", "2023"} {
+		if got := counter.count("/fixtures", season); got != 1 {
+			t.Errorf("season %s fetched %d times, want 1", season, got)
+		}
+	}
+
+	fixtureIDs := make(map[int]bool)
+	for _, seasonResp := range responses {
+		for _, dto := range seasonResp.Response {
+			fixtureIDs[dto.Fixture.ID] = true
+		}
+	}
+	if len(fixtureIDs) == 0 {
+		t.Fatal("SaveFixtures() returned no fixtures")
+	}
+
+	for run := 1; run <= 2; run++ {
+		var stored int64
+		if err := db.Model(&model.Fixture{}).Count(&stored).Error; err != nil {
+			t.Fatalf("failed to count fixtures: %v", err)
+		}
+		if stored != int64(len(fixtureIDs)) {
+			t.Errorf("run %d stored %d fixtures, want %d", run, stored, len(fixtureIDs))
+		}
+
+		if _, err := svc.SaveFixtures(ctx, 0, []int{2022, 2023}); err != nil {
+			t.Fatalf("SaveFixtures() re-import error = %v", err)
+		}
+	}
+}
+
+var errWriteFailed = errors.New("write failed")
+
+
+// failWrites makes every insert into table fail, so an importer breaks part of
+// the way through its transaction.
+func failWrites(t *testing.T, db *gorm.DB, table string) {
+	t.Helper()
+
+	err := db.Callback().Create().Before("gorm:create").Register("test:fail_writes", func(tx *gorm.DB) {
+		if tx.Statement.Table == table {
+			tx.AddError(errWriteFailed)
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to register callback: %v", err)
+	}
+}
+
+
+func TestImportRollsBackFailedEntity(t *testing.T) {
+	tests := []struct {
+		name		string
+		tables		[]string
+		failing		string
+		entity		string
+		written		any
+		save		func(ctx context.Context, svc service.Service) error
+	}{
+		{
+			name: 		"fixtures",
+			tables: 	[]string{"fixtures"},
+			failing: 	"fixtures",
+			entity: 	"fixtures",
+			written: 	&model.Fixture{},
+			save: 		func(ctx context.Context, svc service.Service) error {
+				_, err := svc.SaveFixtures(ctx, 0, []int{2023})
+				return err
+			},
+		},
+		{
+			name: 		"coach careers after coaches",
+			tables: 	[]string{"coaches", "coach_careers"},
+			failing: 	"coach_careers",
+			entity: 	"coaches",
+			written: 	&model.Coach{},
+			save: 		func(ctx context.Context, svc service.Service) error {
+				_, err := svc.SaveCoaches(ctx, 0)
+				return err
+			},
+		},
+		{
+			name: 		"lineup players after lineups",
+			tables: 	[]string{"fixtures", "fixture_lineups", "fixture_lineup_players"},
+			failing: 	"fixture_lineup_players",
+			entity: 	"fixture lineups",
+			written: 	&model.FixtureLineup{},
+			save: 		func(ctx context.Context, svc service.Service) error {
+				if _, err := svc.SaveFixtures(ctx, 0, []int{2023}); err != nil {
+					return err
+				}
+				_, err := svc.SaveFixtureLineups(ctx, 0, []int{2023})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDB(t, tt.tables...)
+			svc, _ := newTestService(t, db)
+			failWrites(t, db, tt.failing)
+
+			err := tt.save(context.Background(), svc)
+
+			var importErr *service.ImportError
+			if !errors.As(err, &importErr) || importErr.Entity != tt.entity {
+				t.Fatalf("error = %v, want an ImportError for %s", err, tt.entity)
+			}
+			if !errors.Is(err, errWriteFailed) {
+				t.Errorf("error = %v, want it to wrap %v", err, errWriteFailed)
+			}
+
+			var stored int64
+			if err := db.Model(tt.written).Count(&stored).Error; err != nil {
+				t.Fatalf("failed to count rows: %v", err)
+			}
+			if stored != 0 {
+				t.Errorf("stored %d %T rows, want the transaction rolled back", stored, tt.written)
+			}
+		})
+	}
+}
+
+
+func TestSaveFixtureLineups(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, "fixtures", "fixture_lineups", "fixture_lineup_players")
+	svc, _ := newTestService(t, db)
+
+	if _, err := svc.SaveFixtures(ctx, 0, []int{2023}); err != nil {
+		t.Fatalf("SaveFixtures() error = %v", err)
+	}
+
+	var played model.Fixture
+	if err := db.Where("season = ?", 2023).Order("timestamp").First(&played).Error; err != nil {
+		t.Fatalf("failed to read a stored fixture: %v", err)
+	}
+
+	// The mock provider has no lineups for this fixture, like a withdrawn feed.
+	withdrawn := model.Fixture{FixtureID: 2023999, LeagueID: 39, Season: 2023, HomeTeamID: 33, AwayTeamID: 40, Timestamp: played.Timestamp + 1}
+	if err := db.Create(&withdrawn).Error; err != nil {
+		t.Fatalf("failed to store a fixture: %v", err)
+	}
+
+	for _, fixtureID := range []int{played.FixtureID, withdrawn.FixtureID} {
+		stale := []any{
+			&model.FixtureLineup{FixtureID: fixtureID, TeamID: 999},
+			&model.FixtureLineupPlayer{FixtureID: fixtureID, TeamID: 33, PlayerID: 999999},
+		}
+		for _, row := range stale {
+			if err := db.Create(row).Error; err != nil {
+				t.Fatalf("failed to store a stale lineup row: %v", err)
+			}
+		}
+	}
+
+	responses, err := svc.SaveFixtureLineups(ctx, 0, []int{2023})
+	if err != nil {
+		t.Fatalf("SaveFixtureLineups() error = %v", err)
+	}
+
+	wantPlayers := make(map[int]bool)
+	for _, dto := range responses[0].Response {
+		for _, entry := range append(dto.StartXI, dto.Substitutes...) {
+			wantPlayers[entry.Player.ID] = true
+		}
+	}
+
+	tests := []struct {
+		name		string
+		model		any
+		fixtureID	int
+		want		int
+	}{
+		{"teams of a played fixture", &model.FixtureLineup{}, played.FixtureID, 2},
+		{"players of a played fixture", &model.FixtureLineupPlayer{}, played.FixtureID, len(wantPlayers)},
+		{"teams of a withdrawn fixture", &model.FixtureLineup{}, withdrawn.FixtureID, 0},
+		{"players of a withdrawn fixture", &model.FixtureLineupPlayer{}, withdrawn.FixtureID, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored int64
+			if err := db.Model(tt.model).Where("fixture_id = ?", tt.fixtureID).Count(&stored).Error; err != nil {
+				t.Fatalf("failed to count rows: %v", err)
+			}
+			if stored != int64(tt.want) {
+				t.Errorf("stored %d rows, want %d", stored, tt.want)
+			}
+		})
+	}
+}
\ No newline at end of file
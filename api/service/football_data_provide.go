@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/deikioveca/TheRedDevilsData/api/model"
 	"gorm.io/gorm"
@@ -11,63 +14,140 @@ import (
 var (
 	ErrCountryNotFound = errors.New("country with that name not found")
 
-	ErrManchesterUnitedNotFound = errors.New("manchester united not found in database")
+	ErrTeamNotFound = errors.New("team not found in database")
+
+	// Deprecated: use ErrTeamNotFound, the tracked team is not always Manchester United.
+	ErrManchesterUnitedNotFound = ErrTeamNotFound
 
 	ErrStandingNotFound = errors.New("standings for this season not found")
 
+	ErrStandingClubNotFound = errors.New("club not found in this season's table")
+
 	ErrFixtureNotFound = errors.New("fixtures for this season not found")
+
+	ErrQuotaNotRecorded = errors.New("api-football quota has not been recorded yet")
+
+	ErrFixtureEventsNotFound = errors.New("events for this fixture not found")
+
+	ErrFixtureLineupsNotFound = errors.New("lineups for this fixture not found")
+
+	ErrPlayerAppearancesNotFound = errors.New("appearances for this player not found")
+
+	ErrFixtureStatisticsNotFound = errors.New("statistics for this fixture not found")
+
+	ErrMatchStatsNotFound = errors.New("match statistics for this season not found")
+
+	ErrPlayerNotFound = errors.New("player not found")
+
+	ErrPlayerStatsNotFound = errors.New("player statistics for this season not found")
+
+	ErrLeaderboardNotFound = errors.New("leaderboard for this season not found")
+
+	ErrCoachNotFound = errors.New("coach not found")
+
+	ErrPlayerTrophiesNotFound = errors.New("trophies for this player not found")
+
+	ErrPlayerSidelinedNotFound = errors.New("sidelined history for this player not found")
+
+	ErrTeamTrophiesNotFound = errors.New("trophies for this team not found, fetch coaches and trophies first")
+
+	ErrHeadToHeadNotFound = errors.New("no finished meetings with this opponent found")
+
+	ErrDistributionNotFound = errors.New("minute and under/over distributions for this season not found, fetch team stats again")
 )
 
 
 type TeamStats interface {
-	GetTeamStatsGames(season int) (*model.ManchesterUnitedGamesDTO, error)
-	GetTeamStatsGoals(season int) (*model.ManchesterUnitedGoalsDTO, error)
-	GetTeamStatsStreak(season int) (*model.ManchesterUnitedStreakDTO, error)
-	GetTeamStatsBiggest(season int) (*model.ManchesterUnitedBiggestDTO, error)
-	GetTeamStatsCleanSheet(season int) (*model.ManchesterUnitedCleanSheetDTO, error)
-	GetTeamStatsFailedToScore(season int) (*model.ManchesterUnitedFailedScoringDTO, error)
-	GetTeamStatsPenalty(season int) (*model.ManchesterUnitedPenaltyDTO, error)
-	GetTeamStatsCards(season int) (*model.ManchesterUnitedCardsDTO, error)
-	GetTeamStatsLineup(season int) (*model.ManchesterUnitedLineupDTO, error)
+	GetTeamStatsGames(ctx context.Context, teamID, season, coachID int) (*model.ManchesterUnitedGamesDTO, error)
+	GetTeamStatsGoals(ctx context.Context, teamID, season, coachID int) (*model.ManchesterUnitedGoalsDTO, error)
+	GetTeamStatsStreak(ctx context.Context, teamID, season int) (*model.ManchesterUnitedStreakDTO, error)
+	GetTeamStatsBiggest(ctx context.Context, teamID, season int) (*model.ManchesterUnitedBiggestDTO, error)
+	GetTeamStatsCleanSheet(ctx context.Context, teamID, season int) (*model.ManchesterUnitedCleanSheetDTO, error)
+	GetTeamStatsFailedToScore(ctx context.Context, teamID, season int) (*model.ManchesterUnitedFailedScoringDTO, error)
+	GetTeamStatsPenalty(ctx context.Context, teamID, season int) (*model.ManchesterUnitedPenaltyDTO, error)
+	GetTeamStatsGoalsMinutes(ctx context.Context, teamID, season int) (*model.ManchesterUnitedGoalsMinutesDTO, error)
+	GetTeamStatsGoalsUnderOver(ctx context.Context, teamID, season int) (*model.ManchesterUnitedGoalsUnderOverDTO, error)
+	GetTeamStatsCards(ctx context.Context, teamID, season int) (*model.ManchesterUnitedCardsDTO, error)
+	GetTeamStatsCardsMinutes(ctx context.Context, teamID, season int) (*model.ManchesterUnitedCardsMinutesDTO, error)
+	GetTeamStatsLineup(ctx context.Context, teamID, season int) (*model.ManchesterUnitedLineupDTO, error)
+	GetTeamStatsComparison(ctx context.Context, teamID int, seasons []int, sections []string) (*model.ManchesterUnitedTeamStatsComparisonDTO, error)
 }
 
 
 type Venue interface {
-	GetVenues() (*model.VenueResponse, error)
-	GetVenuesByCity(city string) (*model.VenueResponse, error)
-	GetVenuesBiggestAndSmallest() (*model.VenueResponse, error)
+	GetVenues(ctx context.Context, q model.ListQuery) (*model.ManchesterUnitedPageDTO[model.VenueDTO], error)
+	GetVenuesByCity(ctx context.Context, city string) (*model.VenueResponse, error)
+	GetVenuesBiggestAndSmallest(ctx context.Context) (*model.VenueResponse, error)
 }
 
 
 type DataProvider interface {
-	GetCountries() (*model.CountryResponse, error)
-	GetCountryByName(countryName string) (*model.CountryDTO, error)
+	GetCountries(ctx context.Context, q model.ListQuery) (*model.ManchesterUnitedPageDTO[model.CountryDTO], error)
+	GetCountryByName(ctx context.Context, countryName string) (*model.CountryDTO, error)
 
-	GetLeagues() ([]*model.ManchesterUnitedLeaguesDTO, error)
+	GetLeagues(ctx context.Context, teamID int, q model.ListQuery) (*model.ManchesterUnitedPageDTO[*model.ManchesterUnitedLeaguesDTO], error)
 
-	GetTeam() (*model.ManchesterUnitedTeamDTO, error)
+	GetTeam(ctx context.Context, teamID int) (*model.ManchesterUnitedTeamDTO, error)
 
 	TeamStats
 
 	Venue
 
-	GetStandingsBySeason(season int) (*model.ManchesterUnitedStandingsDTO, error)
+	GetStandingsBySeason(ctx context.Context, teamID, season, clubID int) (*model.ManchesterUnitedLeagueTableDTO, error)
+
+	GetStandingsProgression(ctx context.Context, teamID, season int) (*model.ManchesterUnitedStandingsProgressionDTO, error)
+
+	GetFixtures(ctx context.Context, teamID int, filter model.FixtureFilter, q model.ListQuery) (*model.ManchesterUnitedPageDTO[*model.ManchesterUnitedFixturesDTO], error)
+
+	GetFixtureEvents(ctx context.Context, fixtureID int) ([]*model.ManchesterUnitedFixtureEventDTO, error)
+
+	GetFixtureLineups(ctx context.Context, fixtureID int) ([]*model.ManchesterUnitedFixtureLineupDTO, error)
+
+	GetPlayerAppearances(ctx context.Context, playerID int) (*model.ManchesterUnitedAppearancesDTO, error)
+
+	GetFixtureStatistics(ctx context.Context, fixtureID int) ([]*model.ManchesterUnitedFixtureStatisticsDTO, error)
+
+	GetSeasonMatchStats(ctx context.Context, teamID, season int) (*model.ManchesterUnitedSeasonMatchStatsDTO, error)
+
+	GetPlayers(ctx context.Context, teamID int) ([]*model.ManchesterUnitedPlayerDTO, error)
+
+	GetPlayer(ctx context.Context, playerID int) (*model.ManchesterUnitedPlayerProfileDTO, error)
+
+	GetPlayerStats(ctx context.Context, playerID, season int) ([]*model.ManchesterUnitedPlayerStatsDTO, error)
+
+	GetLeaderboard(ctx context.Context, teamID, season int, metric string) (*model.ManchesterUnitedLeaderboardDTO, error)
+
+	GetInjuriesBySeason(ctx context.Context, teamID, season int) (map[int][]*model.ManchesterUnitedInjuriesDTO, error)
+
+	GetSquad(ctx context.Context, teamID int, q model.ListQuery) (*model.ManchesterUnitedSquadPageDTO, error)
+
+	GetTransfers(ctx context.Context, teamID int, filter model.TransferFilter) ([]*model.ManchesterUnitedTransferDTO, error)
+
+	GetCoaches(ctx context.Context, teamID int) ([]*model.ManchesterUnitedCoachDTO, error)
 
-	GetFixturesBySeason(season int) ([]*model.ManchesterUnitedFixturesDTO, error)
+	GetCoach(ctx context.Context, teamID, coachID int) (*model.ManchesterUnitedCoachProfileDTO, error)
 
-	GetInjuriesBySeason(season int) (map[int][]*model.ManchesterUnitedInjuriesDTO, error)
+	GetPlayerTrophies(ctx context.Context, playerID int) (*model.ManchesterUnitedPlayerTrophiesDTO, error)
 
-	GetSquad() (*model.ManchesterUnitedSquadDTO, error)
+	GetPlayerSidelined(ctx context.Context, playerID int) (*model.ManchesterUnitedPlayerSidelinedDTO, error)
+
+	GetTeamTrophies(ctx context.Context, teamID int) (*model.ManchesterUnitedClubTrophiesDTO, error)
+
+	GetHeadToHead(ctx context.Context, teamID, opponentID, last int) (*model.ManchesterUnitedHeadToHeadDTO, error)
+
+	GetSeasonSummary(ctx context.Context, teamID, season int) (*model.ManchesterUnitedSeasonSummaryDTO, error)
+
+	GetAPIQuota(ctx context.Context) (*model.APIQuotaDTO, error)
 }
 
 
-func (s *service) GetCountries() (*model.CountryResponse, error) {
-	var countries []model.Country
-	if err := s.db.Find(&countries).Error; err != nil {
+func (s *service) GetCountries(ctx context.Context, q model.ListQuery) (*model.ManchesterUnitedPageDTO[model.CountryDTO], error) {
+	countries, pagination, err := findPage[model.Country](s.db.WithContext(ctx).Model(&model.Country{}), q, countryListFields)
+	if err != nil {
 		return nil, err
 	}
 
-	countryResponse := &model.CountryResponse{Results: len(countries)}
+	countryResponse := &model.ManchesterUnitedPageDTO[model.CountryDTO]{Results: len(countries), Pagination: pagination, Response: []model.CountryDTO{}}
 	
 	for _, c := range countries {
 		countryDTO := &model.CountryDTO{
@@ -81,9 +161,9 @@ func (s *service) GetCountries() (*model.CountryResponse, error) {
 }
 
 
-func (s *service) GetCountryByName(countryName string) (*model.CountryDTO, error) {
+func (s *service) GetCountryByName(ctx context.Context, countryName string) (*model.CountryDTO, error) {
 	var country model.Country
-	if err := s.db.Where("name = ?", countryName).First(&country).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("name = ?", countryName).First(&country).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCountryNotFound
 		}
@@ -94,9 +174,14 @@ func (s *service) GetCountryByName(countryName string) (*model.CountryDTO, error
 }
 
 
-func (s *service) GetLeagues() ([]*model.ManchesterUnitedLeaguesDTO, error) {
-	var leagues []model.League
-	if err := s.db.Find(&leagues).Error; err != nil {
+func (s *service) GetLeagues(ctx context.Context, teamID int, q model.ListQuery) (*model.ManchesterUnitedPageDTO[*model.ManchesterUnitedLeaguesDTO], error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	leagues, pagination, err := findPage[model.League](s.db.WithContext(ctx).Model(&model.League{}).Where("team_id = ?", team.TeamID), q, leagueListFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -114,15 +199,20 @@ func (s *service) GetLeagues() ([]*model.ManchesterUnitedLeaguesDTO, error) {
 		ManUtdLeagues = append(ManUtdLeagues, manUtdLeagueDTO)
 	}
 
-	return ManUtdLeagues, nil
+	return &model.ManchesterUnitedPageDTO[*model.ManchesterUnitedLeaguesDTO]{Results: len(ManUtdLeagues), Pagination: pagination, Response: ManUtdLeagues}, nil
 }
 
 
-func (s *service) GetTeam() (*model.ManchesterUnitedTeamDTO, error) {
+func (s *service) GetTeam(ctx context.Context, teamID int) (*model.ManchesterUnitedTeamDTO, error) {
+	trackedTeam, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
 	var team model.Team
-	if err := s.db.First(&team, 1).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("team_id = ?", trackedTeam.TeamID).First(&team).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrManchesterUnitedNotFound
+			return nil, ErrTeamNotFound
 		}
 		return nil, err
 	}
@@ -143,198 +233,311 @@ func (s *service) GetTeam() (*model.ManchesterUnitedTeamDTO, error) {
 }
 
 
-func (s *service) GetTeamStatsGames(season int) (*model.ManchesterUnitedGamesDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsGames(ctx context.Context, teamID, season, coachID int) (*model.ManchesterUnitedGamesDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedGamesDTO := &model.ManchesterUnitedGamesDTO{
-		Team:	*munTeamStatsDTO,
-		PlayedHome: 	teamStats.PlayedHome,
-		PlayedAway: 	teamStats.PlayedAway,
-		PlayedTotal: 	teamStats.PlayedTotal,
-		WinsHome:		teamStats.WinsHome,
-		WinsAway:		teamStats.WinsAway,
-		WinsTotal:  	teamStats.WinsTotal,
-		DrawsHome:  	teamStats.DrawsHome,
-		DrawsAway:  	teamStats.DrawsAway,
-		DrawsTotal:  	teamStats.DrawsTotal,
-		LosesHome:   	teamStats.LosesHome,
-		LosesAway:   	teamStats.LosesAway,
-		LosesTotal:  	teamStats.LosesTotal,
+	if coachID != 0 {
+		fixtures, err := s.finishedFixtures(ctx, teamStats.TeamID, teamStats.LeagueID, season, coachID)
+		if err != nil {
+			return nil, err
+		}
+		return tenureGames(*munTeamStatsDTO, fixtures, teamStats.TeamID), nil
 	}
 
-	return manchesterUnitedGamesDTO, nil
+	return teamStatsGames(teamStats, *munTeamStatsDTO), nil
 }
 
 
-func (s *service) GetTeamStatsGoals(season int) (*model.ManchesterUnitedGoalsDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsGoals(ctx context.Context, teamID, season, coachID int) (*model.ManchesterUnitedGoalsDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedGoalsDTO := &model.ManchesterUnitedGoalsDTO{
-		Team: *munTeamStatsDTO,
-		GoalsForHome: 			teamStats.GoalsForHome,
-		GoalsForAway: 			teamStats.GoalsForAway,
-		GoalsForTotal: 			teamStats.GoalsForTotal,
-		GoalsAgainstHome: 		teamStats.GoalsAgainstHome,
-		GoalsAgainstAway: 		teamStats.GoalsAgainstAway,
-		GoalsAgainstTotal: 		teamStats.GoalsAgainstTotal,
-		GoalsForAvgHome: 		teamStats.GoalsForAvgHome,
-		GoalsForAvgAway: 		teamStats.GoalsForAvgAway,
-		GoalsForAvgTotal: 		teamStats.GoalsForAvgTotal,
-		GoalsAgainstAvgHome:	teamStats.GoalsAgainstAvgHome,
-		GoalsAgainstAvgAway: 	teamStats.GoalsAgainstAvgAway,
-		GoalsAgainstAvgTotal: 	teamStats.GoalsAgainstAvgTotal,
+	if coachID != 0 {
+		fixtures, err := s.finishedFixtures(ctx, teamStats.TeamID, teamStats.LeagueID, season, coachID)
+		if err != nil {
+			return nil, err
+		}
+		return tenureGoals(*munTeamStatsDTO, fixtures, teamStats.TeamID), nil
 	}
 
-	return manchesterUnitedGoalsDTO, nil
+	return teamStatsGoals(teamStats, *munTeamStatsDTO), nil
 }
 
 
-func (s *service) GetTeamStatsStreak(season int) (*model.ManchesterUnitedStreakDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsStreak(ctx context.Context, teamID, season int) (*model.ManchesterUnitedStreakDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedStreakDTO := &model.ManchesterUnitedStreakDTO{
-		Team: *munTeamStatsDTO,
-		StreakWins: 	teamStats.StreakWins,
-		StreakDraws: 	teamStats.StreakDraws,
-		StreakLoses: 	teamStats.StreakLoses,
+	return teamStatsStreak(teamStats, *munTeamStatsDTO), nil
+}
+
+
+func (s *service) GetTeamStatsBiggest(ctx context.Context, teamID, season int) (*model.ManchesterUnitedBiggestDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
+	if err != nil {
+		return nil, err
 	}
 
-	return manchesterUnitedStreakDTO, nil
+	return teamStatsBiggest(teamStats, *munTeamStatsDTO), nil
 }
 
 
-func (s *service) GetTeamStatsBiggest(season int) (*model.ManchesterUnitedBiggestDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsCleanSheet(ctx context.Context, teamID, season int) (*model.ManchesterUnitedCleanSheetDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedBiggestDTO := &model.ManchesterUnitedBiggestDTO{
-		Team:	*munTeamStatsDTO,
-		BiggestWinHome: 			teamStats.BiggestWinHome,
-		BiggestWinAway: 			teamStats.BiggestWinAway,
-		BiggestLoseHome: 			teamStats.BiggestLoseHome,
-		BiggestLoseAway: 			teamStats.BiggestLoseAway,
-		BiggestGoalsForHome: 		teamStats.BiggestGoalsForHome,
-		BiggestGoalsForAway: 		teamStats.BiggestGoalsForAway,
-		BiggestGoalsAgainstHome: 	teamStats.BiggestGoalsAgainstHome,
-		BiggestGoalsAgainstAway: 	teamStats.BiggestGoalsAgainstAway,
+	return teamStatsCleanSheet(teamStats, *munTeamStatsDTO), nil
+}
+
+
+func (s *service) GetTeamStatsFailedToScore(ctx context.Context, teamID, season int) (*model.ManchesterUnitedFailedScoringDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
+	if err != nil {
+		return nil, err
 	}
 
-	return manchesterUnitedBiggestDTO, nil
+	return teamStatsFailedToScore(teamStats, *munTeamStatsDTO), nil
 }
 
 
-func (s *service) GetTeamStatsCleanSheet(season int) (*model.ManchesterUnitedCleanSheetDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsPenalty(ctx context.Context, teamID, season int) (*model.ManchesterUnitedPenaltyDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedCleanSheetDTO := &model.ManchesterUnitedCleanSheetDTO{
-		Team: *munTeamStatsDTO,
-		CleanSheetHome: 	teamStats.CleanSheetHome,
-		CleanSheetAway: 	teamStats.CleanSheetAway,
-		CleanSheetTotal: 	teamStats.CleanSheetTotal,
+	return teamStatsPenalty(teamStats, *munTeamStatsDTO), nil
+}
+
+
+func (s *service) GetTeamStatsCards(ctx context.Context, teamID, season int) (*model.ManchesterUnitedCardsDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
+	if err != nil {
+		return nil, err
 	}
 
-	return manchesterUnitedCleanSheetDTO, nil
+	return teamStatsCards(teamStats, *munTeamStatsDTO), nil
 }
 
 
-func (s *service) GetTeamStatsFailedToScore(season int) (*model.ManchesterUnitedFailedScoringDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsGoalsMinutes(ctx context.Context, teamID, season int) (*model.ManchesterUnitedGoalsMinutesDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedFailedScoringDTO := &model.ManchesterUnitedFailedScoringDTO{
-		Team: *munTeamStatsDTO,
-		FailedToScoreHome: 	teamStats.FailedToScoreHome,
-		FailedToScoreAway: 	teamStats.FailedToScoreAway,
-		FailedToScoreTotal: teamStats.FailedToScoreTotal,
+	var minutes []model.TeamGoalsMinute
+	if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", teamStats.Season, teamStats.TeamID).Find(&minutes).Error; err != nil {
+		return nil, err
+	}
+
+	if len(minutes) == 0 {
+		return nil, ErrDistributionNotFound
+	}
+
+	sort.Slice(minutes, func(i, j int) bool {
+		return minuteStart(minutes[i].Minute) < minuteStart(minutes[j].Minute)
+	})
+
+	manchesterUnitedGoalsMinutesDTO := &model.ManchesterUnitedGoalsMinutesDTO{
+		Team: 		*munTeamStatsDTO,
+		For: 		[]model.ManchesterUnitedMinuteDTO{},
+		Against: 	[]model.ManchesterUnitedMinuteDTO{},
+	}
+
+	for _, m := range minutes {
+		minute := model.ManchesterUnitedMinuteDTO{Minute: m.Minute, Total: m.Total, Percentage: m.Percentage}
+		if m.Side == model.GoalsSideFor {
+			manchesterUnitedGoalsMinutesDTO.For = append(manchesterUnitedGoalsMinutesDTO.For, minute)
+		} else {
+			manchesterUnitedGoalsMinutesDTO.Against = append(manchesterUnitedGoalsMinutesDTO.Against, minute)
+		}
 	}
 
-	return manchesterUnitedFailedScoringDTO, nil
+	return manchesterUnitedGoalsMinutesDTO, nil
 }
 
 
-func (s *service) GetTeamStatsPenalty(season int) (*model.ManchesterUnitedPenaltyDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsGoalsUnderOver(ctx context.Context, teamID, season int) (*model.ManchesterUnitedGoalsUnderOverDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedPenaltyDTO := &model.ManchesterUnitedPenaltyDTO{
-		Team: *munTeamStatsDTO,
-		PenaltyScoredTotal: 	teamStats.PenaltyScoredTotal,
-		PenaltyScoredPct: 		*teamStats.PenaltyScoredPct,
-		PenaltyMissedTotal: 	teamStats.PenaltyMissedTotal,
-		PenaltyMissedPct: 		*teamStats.PenaltyMissedPct,
-		PenaltyTotal: 			teamStats.PenaltyTotal,
+	var lines []model.TeamGoalsUnderOver
+	if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", teamStats.Season, teamStats.TeamID).Find(&lines).Error; err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrDistributionNotFound
+	}
+
+	sort.Slice(lines, func(i, j int) bool {
+		a, _ := strconv.ParseFloat(lines[i].Line, 64)
+		b, _ := strconv.ParseFloat(lines[j].Line, 64)
+		return a < b
+	})
+
+	manchesterUnitedGoalsUnderOverDTO := &model.ManchesterUnitedGoalsUnderOverDTO{
+		Team: 		*munTeamStatsDTO,
+		For: 		[]model.ManchesterUnitedUnderOverDTO{},
+		Against: 	[]model.ManchesterUnitedUnderOverDTO{},
+	}
+
+	for _, l := range lines {
+		line := model.ManchesterUnitedUnderOverDTO{Line: l.Line, Over: l.Over, Under: l.Under}
+		if l.Side == model.GoalsSideFor {
+			manchesterUnitedGoalsUnderOverDTO.For = append(manchesterUnitedGoalsUnderOverDTO.For, line)
+		} else {
+			manchesterUnitedGoalsUnderOverDTO.Against = append(manchesterUnitedGoalsUnderOverDTO.Against, line)
+		}
 	}
 
-	return manchesterUnitedPenaltyDTO, nil
+	return manchesterUnitedGoalsUnderOverDTO, nil
 }
 
 
-func (s *service) GetTeamStatsCards(season int) (*model.ManchesterUnitedCardsDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsCardsMinutes(ctx context.Context, teamID, season int) (*model.ManchesterUnitedCardsMinutesDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedCardsDTO := &model.ManchesterUnitedCardsDTO{
-		Team: *munTeamStatsDTO,
-		YellowCardsTotal: 	*teamStats.YellowCardsTotal,
-		RedCardsTotal: 		*teamStats.RedCardsTotal,
+	var minutes []model.TeamCardsMinute
+	if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", teamStats.Season, teamStats.TeamID).Find(&minutes).Error; err != nil {
+		return nil, err
+	}
+
+	if len(minutes) == 0 {
+		return nil, ErrDistributionNotFound
+	}
+
+	sort.Slice(minutes, func(i, j int) bool {
+		return minuteStart(minutes[i].Minute) < minuteStart(minutes[j].Minute)
+	})
+
+	manchesterUnitedCardsMinutesDTO := &model.ManchesterUnitedCardsMinutesDTO{
+		Team: 		*munTeamStatsDTO,
+		Yellow: 	[]model.ManchesterUnitedMinuteDTO{},
+		Red: 		[]model.ManchesterUnitedMinuteDTO{},
+	}
+
+	for _, m := range minutes {
+		minute := model.ManchesterUnitedMinuteDTO{Minute: m.Minute, Total: m.Total, Percentage: m.Percentage}
+		if m.Color == model.CardColorYellow {
+			manchesterUnitedCardsMinutesDTO.Yellow = append(manchesterUnitedCardsMinutesDTO.Yellow, minute)
+		} else {
+			manchesterUnitedCardsMinutesDTO.Red = append(manchesterUnitedCardsMinutesDTO.Red, minute)
+		}
 	}
 
-	return manchesterUnitedCardsDTO, nil
+	return manchesterUnitedCardsMinutesDTO, nil
 }
 
 
-func (s *service) GetTeamStatsLineup(season int) (*model.ManchesterUnitedLineupDTO, error) {
-	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(season)
+func (s *service) GetTeamStatsLineup(ctx context.Context, teamID, season int) (*model.ManchesterUnitedLineupDTO, error) {
+	teamStats, munTeamStatsDTO, err := s.getTeamStatsBySeason(ctx, teamID, season)
 	if err != nil {
 		return nil, err
 	}
 
-	lineups, err := s.getLineupsBySeason(teamStats.Season)
+	lineups, err := s.getLineupsBySeason(ctx, teamStats.TeamID, teamStats.Season)
 	if err != nil {
 		return nil, err
 	}
 
-	lineupDTOs := make(map[int][]model.LineupDTO)
-	for _, l := range lineups {
-		lineupDTOs[teamStats.Season] = append(lineupDTOs[teamStats.Season], model.LineupDTO{Formation: l.Formation, Played: l.Played})
+	return teamStatsLineup(teamStats, *munTeamStatsDTO, lineups), nil
+}
+
+
+func (s *service) GetTeamStatsComparison(ctx context.Context, teamID int, seasons []int, sections []string) (*model.ManchesterUnitedTeamStatsComparisonDTO, error) {
+	if len(sections) == 0 {
+		sections = comparisonSections
+	}
+
+	valid := make(map[string]bool, len(comparisonSections))
+	for _, section := range comparisonSections {
+		valid[section] = true
+	}
+	for _, section := range sections {
+		if !valid[section] {
+			return nil, ErrInvalidComparisonSection
+		}
+	}
+
+	seen := make(map[int]bool, len(seasons))
+	var ordered []int
+	for _, season := range seasons {
+		if !seen[season] {
+			seen[season] = true
+			ordered = append(ordered, season)
+		}
+	}
+	sort.Ints(ordered)
+
+	if len(ordered) < 2 {
+		return nil, ErrComparisonSeasons
+	}
+
+	stats := make([]*model.TeamStats, len(ordered))
+	var munTeamStatsDTO *model.ManchesterUnitedTeamStatsDTO
+	for i, season := range ordered {
+		teamStats, dto, err := s.getTeamStatsBySeason(ctx, teamID, season)
+		if err != nil {
+			return nil, err
+		}
+		stats[i], munTeamStatsDTO = teamStats, dto
+	}
+
+	comparison := &model.ManchesterUnitedTeamStatsComparisonDTO{
+		Name: 		munTeamStatsDTO.Name,
+		League: 	munTeamStatsDTO.League,
+		Seasons: 	ordered,
 	}
 
-	manchesterUnitedLineupDTO := &model.ManchesterUnitedLineupDTO{
-		Team: *munTeamStatsDTO,
-		Lineup: lineupDTOs,
+	for _, section := range sections {
+		sectionDTO := model.ManchesterUnitedComparisonSectionDTO{Section: section}
+
+		bySeason := make([][]comparisonValue, len(stats))
+		for i, teamStats := range stats {
+			bySeason[i] = comparisonValues(section, teamStats)
+		}
+
+		for m, value := range bySeason[0] {
+			metric := model.ManchesterUnitedComparisonMetricDTO{Metric: value.metric, Deltas: []model.ManchesterUnitedDeltaDTO{}}
+			for i := range stats {
+				metric.Values = append(metric.Values, bySeason[i][m].value)
+				if i > 0 {
+					metric.Deltas = append(metric.Deltas, seasonDelta(ordered[i - 1], ordered[i], bySeason[i - 1][m].value, bySeason[i][m].value))
+				}
+			}
+			sectionDTO.Metrics = append(sectionDTO.Metrics, metric)
+		}
+
+		comparison.Sections = append(comparison.Sections, sectionDTO)
 	}
 
-	return manchesterUnitedLineupDTO, nil
+	return comparison, nil
 }
 
 
-func (s *service) GetVenues() (*model.VenueResponse, error) {
-	var venues []model.Venue
-	if err := s.db.Find(&venues).Error; err != nil {
+func (s *service) GetVenues(ctx context.Context, q model.ListQuery) (*model.ManchesterUnitedPageDTO[model.VenueDTO], error) {
+	venues, pagination, err := findPage[model.Venue](s.db.WithContext(ctx).Model(&model.Venue{}), q, venueListFields)
+	if err != nil {
 		return nil, err
 	}
 
-	venueResponse := &model.VenueResponse{Results: len(venues)}
+	venueResponse := &model.ManchesterUnitedPageDTO[model.VenueDTO]{Results: len(venues), Pagination: pagination, Response: []model.VenueDTO{}}
 	for _, v := range venues {
 		venueDTO := &model.VenueDTO{
 			VenueID: 	v.VenueID,
@@ -351,9 +554,9 @@ func (s *service) GetVenues() (*model.VenueResponse, error) {
 }
 
 
-func (s *service) GetVenuesByCity(city string) (*model.VenueResponse, error) {
+func (s *service) GetVenuesByCity(ctx context.Context, city string) (*model.VenueResponse, error) {
 	var venues []model.Venue
-	if err := s.db.Where("city = ?", city).Find(&venues).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("city = ?", city).Find(&venues).Error; err != nil {
 		return nil, err
 	}
 
@@ -374,9 +577,9 @@ func (s *service) GetVenuesByCity(city string) (*model.VenueResponse, error) {
 }
 
 
-func (s *service) GetVenuesBiggestAndSmallest() (*model.VenueResponse, error) {
+func (s *service) GetVenuesBiggestAndSmallest(ctx context.Context) (*model.VenueResponse, error) {
 	var venues []model.Venue
-	if err := s.db.Find(&venues).Error; err != nil {
+	if err := s.db.WithContext(ctx).Find(&venues).Error; err != nil {
 		return nil, err
 	}
 
@@ -405,56 +608,149 @@ func (s *service) GetVenuesBiggestAndSmallest() (*model.VenueResponse, error) {
 }
 
 
-func (s *service) GetStandingsBySeason(season int) (*model.ManchesterUnitedStandingsDTO, error) {
-	var standing model.Standing
-	if err := s.db.Where("season = ?", season).First(&standing).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrStandingNotFound
+// GetStandingsBySeason returns the tracked team's league table, narrowed to the
+// row of clubID when it is not 0. Any club in the table can be picked, tracked
+// or not.
+func (s *service) GetStandingsBySeason(ctx context.Context, teamID, season, clubID int) (*model.ManchesterUnitedLeagueTableDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var standings []model.Standing
+	if err := s.db.WithContext(ctx).Where("league_id = ? AND season = ?", team.LeagueID, season).Order("rank").Find(&standings).Error; err != nil {
+		return nil, err
+	}
+
+	if len(standings) == 0 {
+		return nil, ErrStandingNotFound
+	}
+
+	points := make([]int, len(standings))
+	for i, standing := range standings {
+		points[i] = standing.Points
+	}
+
+	table := &model.ManchesterUnitedLeagueTableDTO{
+		LeagueName: standings[0].LeagueName,
+		Season: 	season,
+		Table: 		[]*model.ManchesterUnitedStandingsDTO{},
+	}
+
+	for i, standing := range standings {
+		if standing.TeamID == team.TeamID {
+			table.Team = &model.ManchesterUnitedTablePositionDTO{
+				TeamName: 		standing.TeamName,
+				Rank: 			standing.Rank,
+				Points: 		standing.Points,
+				Status: 		standing.Status,
+				GapToTopFour: 	gapToTopFour(i, points),
+			}
+		}
+
+		if clubID != 0 && standing.TeamID != clubID {
+			continue
 		}
+
+		table.Table = append(table.Table, &model.ManchesterUnitedStandingsDTO{
+			LeagueName: 		standing.LeagueName,
+			Season: 			standing.Season,
+			TeamID: 			standing.TeamID,
+			TeamName: 			standing.TeamName,
+			Rank: 				standing.Rank,
+			Points: 			standing.Points,
+			GoalsDiff: 			standing.GoalsDiff,
+			Description: 		standing.Description,
+			Form: 				standing.Form,
+			Status: 			standing.Status,
+			PlayedAll: 			standing.PlayedAll,
+			WinsAll: 			standing.WinsAll,
+			DrawsAll: 			standing.DrawsAll,
+			LosesAll: 			standing.LosesAll,
+			GoalsForAll: 		standing.GoalsForAll,
+			GoalsAgainstAll: 	standing.GoalsAgainstAll,
+			PlayedHome: 		standing.PlayedHome,
+			WinsHome: 			standing.WinsHome,
+			DrawsHome: 			standing.DrawsHome,
+			LosesHome: 			standing.LosesHome,
+			GoalsForHome: 		standing.GoalsForHome,
+			GoalsAgainstHome: 	standing.GoalsAgainstHome,
+			PlayedAway: 		standing.PlayedAway,
+			WinsAway: 			standing.WinsAway,
+			DrawsAway: 			standing.DrawsAway,
+			LosesAway: 			standing.LosesAway,
+			GoalsForAway: 		standing.GoalsForAway,
+			GoalsAgainstAway: 	standing.GoalsAgainstAway,
+		})
+	}
+
+	if len(table.Table) == 0 {
+		return nil, ErrStandingClubNotFound
+	}
+
+	if table.Team == nil {
+		return table, nil
+	}
+
+	fixtures, err := s.leagueFixtures(ctx, team, season)
+	if err != nil && err != ErrNoLeagueFixtures {
+		return nil, err
+	}
+
+	if progression := standingsProgression(fixtures, team.TeamID); len(progression) > 1 {
+		table.Team.PreviousRank = progression[len(progression) - 2].Rank
+		table.Team.RankMovement = table.Team.PreviousRank - table.Team.Rank
+	}
+
+	return table, nil
+}
+
+
+func (s *service) GetStandingsProgression(ctx context.Context, teamID, season int) (*model.ManchesterUnitedStandingsProgressionDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := s.leagueFixtures(ctx, team, season)
+	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedStandingsDTO := &model.ManchesterUnitedStandingsDTO{
-		LeagueName: 		standing.LeagueName,
-		Season: 			standing.Season,
-		TeamName: 			standing.TeamName,
-		Rank: 				standing.Rank,
-		Points: 			standing.Points,
-		GoalsDiff: 			standing.GoalsDiff,
-		Description: 		standing.Description,
-		PlayedAll: 			standing.PlayedAll,
-		WinsAll: 			standing.WinsAll,
-		DrawsAll: 			standing.DrawsAll,
-		LosesAll: 			standing.LosesAll,
-		GoalsForAll: 		standing.GoalsForAll,
-		GoalsAgainstAll: 	standing.GoalsAgainstAll,
-		PlayedHome: 		standing.PlayedHome,
-		WinsHome: 			standing.WinsHome,
-		DrawsHome: 			standing.DrawsHome,
-		LosesHome: 			standing.LosesHome,
-		GoalsForHome: 		standing.GoalsForHome,
-		GoalsAgainstHome: 	standing.GoalsAgainstHome,
-		PlayedAway: 		standing.PlayedAway,
-		WinsAway: 			standing.WinsAway,
-		DrawsAway: 			standing.DrawsAway,
-		LosesAway: 			standing.LosesAway,
-		GoalsForAway: 		standing.GoalsForAway,
-		GoalsAgainstAway: 	standing.GoalsAgainstAway,
+	rounds := standingsProgression(fixtures, team.TeamID)
+	if len(rounds) == 0 {
+		return nil, ErrNoLeagueFixtures
 	}
 
-	return manchesterUnitedStandingsDTO, nil
+	return &model.ManchesterUnitedStandingsProgressionDTO{
+		LeagueName: fixtures[0].LeagueName,
+		Season: 	season,
+		TeamName: 	team.TeamName,
+		Rounds: 	rounds,
+	}, nil
 }
 
 
-func (s *service) GetFixturesBySeason(season int) ([]*model.ManchesterUnitedFixturesDTO, error) {
-	var fixtures []model.Fixture
-	if err := s.db.Where("season = ?", season).Find(&fixtures).Error; err != nil {
+func (s *service) GetFixtures(ctx context.Context, teamID int, filter model.FixtureFilter, q model.ListQuery) (*model.ManchesterUnitedPageDTO[*model.ManchesterUnitedFixturesDTO], error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := s.filterFixtures(ctx, s.teamFixtures(ctx, team.TeamID, team.LeagueID), team.TeamID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, pagination, err := findPage[model.Fixture](query, q, fixtureListFields)
+	if err != nil {
 		return nil, err
 	}
 
 	manchesterUnitedFixturesDTO := []*model.ManchesterUnitedFixturesDTO{}
 	for _, fixture := range fixtures {
 		manchesterUnitedFixturesDTO = append(manchesterUnitedFixturesDTO, &model.ManchesterUnitedFixturesDTO{
+			FixtureID: 			fixture.FixtureID,
 			Referee: 			fixture.Referee,
     		Date: 				fixture.Date,
     		VenueName: 			fixture.VenueName,
@@ -484,51 +780,1014 @@ func (s *service) GetFixturesBySeason(season int) ([]*model.ManchesterUnitedFixt
 		})
 	}
 
-	return manchesterUnitedFixturesDTO, nil
+	return &model.ManchesterUnitedPageDTO[*model.ManchesterUnitedFixturesDTO]{Results: len(manchesterUnitedFixturesDTO), Pagination: pagination, Response: manchesterUnitedFixturesDTO}, nil
 }
 
 
-func (s *service) GetInjuriesBySeason(season int) (map[int][]*model.ManchesterUnitedInjuriesDTO, error) {
-	var injuries []model.Injury
-	if err := s.db.Where("season = ?", season).Find(&injuries).Error; err != nil {
+func (s *service) GetFixtureEvents(ctx context.Context, fixtureID int) ([]*model.ManchesterUnitedFixtureEventDTO, error) {
+	var events []model.FixtureEvent
+	if err := s.db.WithContext(ctx).Where("fixture_id = ?", fixtureID).Order("sequence").Find(&events).Error; err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedInjuriesDTO := []*model.ManchesterUnitedInjuriesDTO{}
-	for _, i := range injuries {
-		manchesterUnitedInjuriesDTO = append(manchesterUnitedInjuriesDTO, &model.ManchesterUnitedInjuriesDTO{
-			PlayerName: 	i.PlayerName,
-			Type: 			i.Type,
-			Reason: 		i.Reason,
-			FixtureDate: 	i.FixtureDate,
-			LeagueName: 	i.LeagueName,
-			Country: 		i.Country,
-			Season: 		i.Season,
+	if len(events) == 0 {
+		return nil, ErrFixtureEventsNotFound
+	}
+
+	manchesterUnitedFixtureEventsDTO := []*model.ManchesterUnitedFixtureEventDTO{}
+	for _, e := range events {
+		manchesterUnitedFixtureEventsDTO = append(manchesterUnitedFixtureEventsDTO, &model.ManchesterUnitedFixtureEventDTO{
+			Elapsed: 		e.Elapsed,
+			Extra: 			safeInt(e.Extra),
+			TeamName: 		e.TeamName,
+			PlayerName: 	e.PlayerName,
+			AssistName: 	e.AssistName,
+			Type: 			e.Type,
+			Detail: 		e.Detail,
+			Comments: 		e.Comments,
 		})
 	}
 
-	dataDTO := make(map[int][]*model.ManchesterUnitedInjuriesDTO)
-	dataDTO[len(injuries)] = manchesterUnitedInjuriesDTO
+	return manchesterUnitedFixtureEventsDTO, nil
+}
 
-	return dataDTO, nil
+
+func (s *service) GetFixtureLineups(ctx context.Context, fixtureID int) ([]*model.ManchesterUnitedFixtureLineupDTO, error) {
+	var lineups []model.FixtureLineup
+	if err := s.db.WithContext(ctx).Where("fixture_id = ?", fixtureID).Order("id").Find(&lineups).Error; err != nil {
+		return nil, err
+	}
+
+	if len(lineups) == 0 {
+		return nil, ErrFixtureLineupsNotFound
+	}
+
+	var players []model.FixtureLineupPlayer
+	if err := s.db.WithContext(ctx).Where("fixture_id = ?", fixtureID).Order("id").Find(&players).Error; err != nil {
+		return nil, err
+	}
+
+	manchesterUnitedFixtureLineupsDTO := []*model.ManchesterUnitedFixtureLineupDTO{}
+	for _, lineup := range lineups {
+		lineupDTO := &model.ManchesterUnitedFixtureLineupDTO{
+			TeamName: 		lineup.TeamName,
+			Formation: 		lineup.Formation,
+			CoachName: 		lineup.CoachName,
+			StartXI: 		[]model.ManchesterUnitedLineupPlayerDTO{},
+			Substitutes: 	[]model.ManchesterUnitedLineupPlayerDTO{},
+		}
+
+		for _, p := range players {
+			if p.TeamID != lineup.TeamID {
+				continue
+			}
+
+			playerDTO := model.ManchesterUnitedLineupPlayerDTO{
+				PlayerID: 		p.PlayerID,
+				PlayerName: 	p.PlayerName,
+				Number: 		p.Number,
+				Position: 		p.Position,
+				Grid: 			p.Grid,
+			}
+			if p.Starter {
+				lineupDTO.StartXI = append(lineupDTO.StartXI, playerDTO)
+			} else {
+				lineupDTO.Substitutes = append(lineupDTO.Substitutes, playerDTO)
+			}
+		}
+
+		manchesterUnitedFixtureLineupsDTO = append(manchesterUnitedFixtureLineupsDTO, lineupDTO)
+	}
+
+	return manchesterUnitedFixtureLineupsDTO, nil
 }
 
 
-func (s *service) GetSquad() (*model.ManchesterUnitedSquadDTO, error) {
-	var squad []model.Squad
-	if err := s.db.Find(&squad).Error; err != nil {
+func (s *service) GetPlayerAppearances(ctx context.Context, playerID int) (*model.ManchesterUnitedAppearancesDTO, error) {
+	var appearances []struct {
+		model.FixtureLineupPlayer
+		Date			string
+		Season			int
+		Round			string
+		HomeTeamName	string
+		AwayTeamName	string
+		GoalsHome		int
+		GoalsAway		int
+	}
+
+	err := s.db.WithContext(ctx).Model(&model.FixtureLineupPlayer{}).
+		Select("fixture_lineup_players.*, fixtures.date, fixtures.season, fixtures.round, fixtures.home_team_name, fixtures.away_team_name, fixtures.goals_home, fixtures.goals_away").
+		Joins("JOIN fixtures ON fixtures.fixture_id = fixture_lineup_players.fixture_id").
+		Where("fixture_lineup_players.player_id = ?", playerID).
+		Order("fixtures.timestamp").
+		Scan(&appearances).Error
+	if err != nil {
 		return nil, err
 	}
 
-	manchesterUnitedSquadDTO := &model.ManchesterUnitedSquadDTO{SquadDepth: len(squad)}
-	for _, player := range squad {
-		manchesterUnitedSquadDTO.Footballers = append(manchesterUnitedSquadDTO.Footballers, model.ManchesterUnitedFootballerDTO{
-			PlayerName: player.PlayerName,
-			Age: 		player.Age,
-			Number: 	player.Number,
-			Position: 	player.Position,
+	if len(appearances) == 0 {
+		return nil, ErrPlayerAppearancesNotFound
+	}
+
+	// a bench player only counts as appearing when a substitution event brought
+	// them on, which needs the fixture events to be imported as well
+	var subbedOn []int
+	err = s.db.WithContext(ctx).Model(&model.FixtureEvent{}).
+		Where("type = ? AND (player_id = ? OR assist_id = ?)", "subst", playerID, playerID).
+		Pluck("fixture_id", &subbedOn).Error
+	if err != nil {
+		return nil, err
+	}
+
+	subbedOnFixtures := make(map[int]bool, len(subbedOn))
+	for _, fixtureID := range subbedOn {
+		subbedOnFixtures[fixtureID] = true
+	}
+
+	manchesterUnitedAppearancesDTO := &model.ManchesterUnitedAppearancesDTO{
+		PlayerID: 		playerID,
+		PlayerName: 	appearances[len(appearances) - 1].PlayerName,
+		Fixtures: 		[]model.ManchesterUnitedAppearanceDTO{},
+	}
+
+	for _, a := range appearances {
+		manchesterUnitedAppearancesDTO.Fixtures = append(manchesterUnitedAppearancesDTO.Fixtures, model.ManchesterUnitedAppearanceDTO{
+			FixtureID: 		a.FixtureID,
+			Date: 			a.Date,
+			Season: 		a.Season,
+			Round: 			a.Round,
+			HomeTeamName: 	a.HomeTeamName,
+			AwayTeamName: 	a.AwayTeamName,
+			GoalsHome: 		a.GoalsHome,
+			GoalsAway: 		a.GoalsAway,
+			Starter: 		a.Starter,
+			SubbedOn: 		!a.Starter && subbedOnFixtures[a.FixtureID],
+			Position: 		a.Position,
+			Number: 		a.Number,
 		})
+
+		switch {
+		case a.Starter:
+			manchesterUnitedAppearancesDTO.Starts++
+			manchesterUnitedAppearancesDTO.Appearances++
+		case subbedOnFixtures[a.FixtureID]:
+			manchesterUnitedAppearancesDTO.Appearances++
+		default:
+			manchesterUnitedAppearancesDTO.UnusedSubs++
+		}
 	}
 
-	return manchesterUnitedSquadDTO, nil
-}
\ No newline at end of file
+	return manchesterUnitedAppearancesDTO, nil
+}
+
+
+func (s *service) GetFixtureStatistics(ctx context.Context, fixtureID int) ([]*model.ManchesterUnitedFixtureStatisticsDTO, error) {
+	var statistics []model.FixtureStatistics
+	if err := s.db.WithContext(ctx).Where("fixture_id = ?", fixtureID).Order("id").Find(&statistics).Error; err != nil {
+		return nil, err
+	}
+
+	if len(statistics) == 0 {
+		return nil, ErrFixtureStatisticsNotFound
+	}
+
+	manchesterUnitedFixtureStatisticsDTO := []*model.ManchesterUnitedFixtureStatisticsDTO{}
+	for _, st := range statistics {
+		manchesterUnitedFixtureStatisticsDTO = append(manchesterUnitedFixtureStatisticsDTO, &model.ManchesterUnitedFixtureStatisticsDTO{
+			TeamName: 			st.TeamName,
+			ShotsOnGoal: 		st.ShotsOnGoal,
+			ShotsOffGoal: 		st.ShotsOffGoal,
+			TotalShots: 		st.TotalShots,
+			BlockedShots: 		st.BlockedShots,
+			ShotsInsideBox: 	st.ShotsInsideBox,
+			ShotsOutsideBox: 	st.ShotsOutsideBox,
+			Fouls: 				st.Fouls,
+			CornerKicks: 		st.CornerKicks,
+			Offsides: 			st.Offsides,
+			BallPossession: 	st.BallPossession,
+			YellowCards: 		st.YellowCards,
+			RedCards: 			st.RedCards,
+			GoalkeeperSaves: 	st.GoalkeeperSaves,
+			TotalPasses: 		st.TotalPasses,
+			PassesAccurate: 	st.PassesAccurate,
+			PassesPct: 			st.PassesPct,
+			ExpectedGoals: 		st.ExpectedGoals,
+		})
+	}
+
+	return manchesterUnitedFixtureStatisticsDTO, nil
+}
+
+
+func (s *service) GetSeasonMatchStats(ctx context.Context, teamID, season int) (*model.ManchesterUnitedSeasonMatchStatsDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var statistics []struct {
+		model.FixtureStatistics
+		HomeTeamID int
+	}
+
+	err = s.db.WithContext(ctx).Model(&model.FixtureStatistics{}).
+		Select("fixture_statistics.*, fixtures.home_team_id").
+		Joins("JOIN fixtures ON fixtures.fixture_id = fixture_statistics.fixture_id").
+		Where("fixtures.season = ? AND fixtures.league_id = ? AND fixture_statistics.team_id = ?", season, team.LeagueID, team.TeamID).
+		Scan(&statistics).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statistics) == 0 {
+		return nil, ErrMatchStatsNotFound
+	}
+
+	var home, away []model.FixtureStatistics
+	for _, st := range statistics {
+		if st.HomeTeamID == team.TeamID {
+			home = append(home, st.FixtureStatistics)
+		} else {
+			away = append(away, st.FixtureStatistics)
+		}
+	}
+
+	return &model.ManchesterUnitedSeasonMatchStatsDTO{
+		Season: 	season,
+		Home: 		matchStatsAverages(home),
+		Away: 		matchStatsAverages(away),
+		Total: 		matchStatsAverages(append(home, away...)),
+	}, nil
+}
+
+
+func matchStatsAverages(statistics []model.FixtureStatistics) model.ManchesterUnitedMatchStatsAveragesDTO {
+	averages := model.ManchesterUnitedMatchStatsAveragesDTO{Matches: len(statistics)}
+	if len(statistics) == 0 {
+		return averages
+	}
+
+	var possession, shots, onTarget, corners, fouls, passes, accurate, expectedGoals float64
+	for _, st := range statistics {
+		possession += float64(st.BallPossession)
+		shots += float64(st.TotalShots)
+		onTarget += float64(st.ShotsOnGoal)
+		corners += float64(st.CornerKicks)
+		fouls += float64(st.Fouls)
+		passes += float64(st.TotalPasses)
+		accurate += float64(st.PassesAccurate)
+		if st.ExpectedGoals != nil {
+			expectedGoals += *st.ExpectedGoals
+		}
+	}
+
+	matches := float64(len(statistics))
+	averages.BallPossession = round2(possession / matches)
+	averages.ShotsPerGame = round2(shots / matches)
+	averages.ShotsOnTargetPerGame = round2(onTarget / matches)
+	averages.CornersPerGame = round2(corners / matches)
+	averages.FoulsPerGame = round2(fouls / matches)
+	averages.PassesPerGame = round2(passes / matches)
+	averages.ExpectedGoalsPerGame = round2(expectedGoals / matches)
+	if passes > 0 {
+		averages.PassAccuracy = round2(accurate * 100 / passes)
+	}
+
+	return averages
+}
+
+
+func (s *service) GetPlayers(ctx context.Context, teamID int) ([]*model.ManchesterUnitedPlayerDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []model.PlayerSeasonStats
+	if err := s.db.WithContext(ctx).Where("team_id = ?", team.TeamID).Order("season DESC").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+
+	positions := make(map[int]string)
+	var playerIDs []int
+	for _, st := range stats {
+		if _, ok := positions[st.PlayerID]; ok {
+			continue
+		}
+		positions[st.PlayerID] = st.Position
+		playerIDs = append(playerIDs, st.PlayerID)
+	}
+
+	var players []model.Player
+	if len(playerIDs) > 0 {
+		if err := s.db.WithContext(ctx).Where("player_id IN ?", playerIDs).Order("name").Find(&players).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	manchesterUnitedPlayersDTO := []*model.ManchesterUnitedPlayerDTO{}
+	for _, p := range players {
+		manchesterUnitedPlayersDTO = append(manchesterUnitedPlayersDTO, &model.ManchesterUnitedPlayerDTO{
+			PlayerID: 		p.PlayerID,
+			Name: 			p.Name,
+			Age: 			p.Age,
+			Nationality: 	p.Nationality,
+			Position: 		positions[p.PlayerID],
+		})
+	}
+
+	return manchesterUnitedPlayersDTO, nil
+}
+
+
+func (s *service) GetPlayer(ctx context.Context, playerID int) (*model.ManchesterUnitedPlayerProfileDTO, error) {
+	var player model.Player
+	if err := s.db.WithContext(ctx).Where("player_id = ?", playerID).First(&player).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	var stats []model.PlayerSeasonStats
+	if err := s.db.WithContext(ctx).Where("player_id = ?", playerID).Order("season DESC, league_id").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+
+	manchesterUnitedPlayerProfileDTO := &model.ManchesterUnitedPlayerProfileDTO{
+		PlayerID: 		player.PlayerID,
+		Name: 			player.Name,
+		Firstname: 		player.Firstname,
+		Lastname: 		player.Lastname,
+		Age: 			player.Age,
+		BirthDate: 		player.BirthDate,
+		BirthPlace: 	player.BirthPlace,
+		BirthCountry: 	player.BirthCountry,
+		Nationality: 	player.Nationality,
+		Height: 		player.Height,
+		Weight: 		player.Weight,
+		Seasons: 		[]model.ManchesterUnitedPlayerSeasonDTO{},
+	}
+
+	for _, st := range stats {
+		manchesterUnitedPlayerProfileDTO.Seasons = append(manchesterUnitedPlayerProfileDTO.Seasons, model.ManchesterUnitedPlayerSeasonDTO{
+			Season: 		st.Season,
+			TeamName: 		st.TeamName,
+			LeagueName: 	st.LeagueName,
+			Appearances: 	st.Appearances,
+			Goals: 			st.Goals,
+			Assists: 		st.Assists,
+		})
+	}
+
+	return manchesterUnitedPlayerProfileDTO, nil
+}
+
+
+func (s *service) GetPlayerStats(ctx context.Context, playerID, season int) ([]*model.ManchesterUnitedPlayerStatsDTO, error) {
+	var stats []model.PlayerSeasonStats
+	if err := s.db.WithContext(ctx).Where("player_id = ? AND season = ?", playerID, season).Order("league_id").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+
+	if len(stats) == 0 {
+		return nil, ErrPlayerStatsNotFound
+	}
+
+	manchesterUnitedPlayerStatsDTO := []*model.ManchesterUnitedPlayerStatsDTO{}
+	for _, st := range stats {
+		manchesterUnitedPlayerStatsDTO = append(manchesterUnitedPlayerStatsDTO, &model.ManchesterUnitedPlayerStatsDTO{
+			TeamName: 			st.TeamName,
+			LeagueName: 		st.LeagueName,
+			Season: 			st.Season,
+			Position: 			st.Position,
+			Appearances: 		st.Appearances,
+			Lineups: 			st.Lineups,
+			Minutes: 			st.Minutes,
+			Rating: 			st.Rating,
+			Goals: 				st.Goals,
+			Assists: 			st.Assists,
+			ShotsTotal: 		st.ShotsTotal,
+			ShotsOn: 			st.ShotsOn,
+			PassesTotal: 		st.PassesTotal,
+			PassesKey: 			st.PassesKey,
+			PassesAccuracy: 	st.PassesAccuracy,
+			Tackles: 			st.Tackles,
+			Interceptions: 		st.Interceptions,
+			DuelsTotal: 		st.DuelsTotal,
+			DuelsWon: 			st.DuelsWon,
+			DribblesAttempts: 	st.DribblesAttempts,
+			DribblesSuccess: 	st.DribblesSuccess,
+			YellowCards: 		st.YellowCards,
+			RedCards: 			st.RedCards,
+			PenaltyScored: 		st.PenaltyScored,
+			PenaltyMissed: 		st.PenaltyMissed,
+		})
+	}
+
+	return manchesterUnitedPlayerStatsDTO, nil
+}
+
+
+func (s *service) GetLeaderboard(ctx context.Context, teamID, season int, metric string) (*model.ManchesterUnitedLeaderboardDTO, error) {
+	if !validLeaderboardMetric(metric) {
+		return nil, ErrInvalidLeaderboardMetric
+	}
+
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []model.LeaderboardEntry
+	if err := s.db.WithContext(ctx).Where("league_id = ? AND season = ? AND metric = ?", team.LeagueID, season, metric).Order("rank").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, ErrLeaderboardNotFound
+	}
+
+	manchesterUnitedLeaderboardDTO := &model.ManchesterUnitedLeaderboardDTO{
+		LeagueID: 		team.LeagueID,
+		Season: 		season,
+		Metric: 		metric,
+		Entries: 		[]model.ManchesterUnitedLeaderboardEntryDTO{},
+		TeamEntries: 	[]model.ManchesterUnitedLeaderboardEntryDTO{},
+	}
+
+	for _, e := range entries {
+		entryDTO := model.ManchesterUnitedLeaderboardEntryDTO{
+			Rank: 			e.Rank,
+			PlayerID: 		e.PlayerID,
+			PlayerName: 	e.PlayerName,
+			TeamName: 		e.TeamName,
+			Value: 			e.Value,
+			Appearances: 	e.Appearances,
+			Minutes: 		e.Minutes,
+			Highlighted: 	e.TeamID == team.TeamID,
+		}
+
+		manchesterUnitedLeaderboardDTO.Entries = append(manchesterUnitedLeaderboardDTO.Entries, entryDTO)
+		if entryDTO.Highlighted {
+			manchesterUnitedLeaderboardDTO.TeamEntries = append(manchesterUnitedLeaderboardDTO.TeamEntries, entryDTO)
+		}
+	}
+
+	return manchesterUnitedLeaderboardDTO, nil
+}
+
+
+func (s *service) GetInjuriesBySeason(ctx context.Context, teamID, season int) (map[int][]*model.ManchesterUnitedInjuriesDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var injuries []model.Injury
+	if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", season, team.TeamID).Find(&injuries).Error; err != nil {
+		return nil, err
+	}
+
+	manchesterUnitedInjuriesDTO := []*model.ManchesterUnitedInjuriesDTO{}
+	for _, i := range injuries {
+		manchesterUnitedInjuriesDTO = append(manchesterUnitedInjuriesDTO, &model.ManchesterUnitedInjuriesDTO{
+			PlayerName: 	i.PlayerName,
+			Type: 			i.Type,
+			Reason: 		i.Reason,
+			FixtureDate: 	i.FixtureDate,
+			LeagueName: 	i.LeagueName,
+			Country: 		i.Country,
+			Season: 		i.Season,
+		})
+	}
+
+	dataDTO := make(map[int][]*model.ManchesterUnitedInjuriesDTO)
+	dataDTO[len(injuries)] = manchesterUnitedInjuriesDTO
+
+	return dataDTO, nil
+}
+
+
+func (s *service) GetSeasonSummary(ctx context.Context, teamID, season int) (*model.ManchesterUnitedSeasonSummaryDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &model.ManchesterUnitedSeasonSummaryDTO{Season: season, Results: []model.ManchesterUnitedMeetingDTO{}}
+
+	// the tracked team and its team stats row are loaded once and every
+	// section is built from them, instead of each getter reading them again
+	err = runConcurrently(ctx,
+		func(ctx context.Context) (err error) {
+			summary.Position, err = s.seasonPosition(ctx, team, season)
+			return missingIsEmpty(err)
+		},
+		func(ctx context.Context) error {
+			teamStats, munTeamStatsDTO, err := s.seasonTeamStats(ctx, team, season)
+			if err != nil {
+				return missingIsEmpty(err)
+			}
+
+			summary.Games 			= teamStatsGames(teamStats, *munTeamStatsDTO)
+			summary.Goals 			= teamStatsGoals(teamStats, *munTeamStatsDTO)
+			summary.Streak 			= teamStatsStreak(teamStats, *munTeamStatsDTO)
+			summary.Biggest 		= teamStatsBiggest(teamStats, *munTeamStatsDTO)
+			summary.CleanSheet 		= teamStatsCleanSheet(teamStats, *munTeamStatsDTO)
+			summary.FailedToScore 	= teamStatsFailedToScore(teamStats, *munTeamStatsDTO)
+			summary.Penalty 		= teamStatsPenalty(teamStats, *munTeamStatsDTO)
+			summary.Cards 			= teamStatsCards(teamStats, *munTeamStatsDTO)
+
+			lineups, err := s.getLineupsBySeason(ctx, team.TeamID, season)
+			if err != nil {
+				return missingIsEmpty(err)
+			}
+			summary.Formations = teamStatsLineup(teamStats, *munTeamStatsDTO, lineups)
+			return nil
+		},
+		func(ctx context.Context) error {
+			fixtures, err := s.finishedFixtures(ctx, team.TeamID, team.LeagueID, season, 0)
+			if err != nil {
+				return err
+			}
+			for _, f := range fixtures {
+				summary.Results = append(summary.Results, meeting(f, team.TeamID))
+			}
+			return nil
+		},
+		func(ctx context.Context) error {
+			var injuries []model.Injury
+			if err := s.db.WithContext(ctx).Where("season = ? AND team_id = ?", season, team.TeamID).Find(&injuries).Error; err != nil {
+				return err
+			}
+			summary.TopInjuries = topInjuries(injuries, 5)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if summary.Games == nil && summary.Position == nil && len(summary.Results) == 0 {
+		return nil, ErrSeasonSummaryNotFound
+	}
+
+	return summary, nil
+}
+
+
+func (s *service) GetSquad(ctx context.Context, teamID int, q model.ListQuery) (*model.ManchesterUnitedSquadPageDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	squad, pagination, err := findPage[model.Squad](s.db.WithContext(ctx).Model(&model.Squad{}).Where("team_id = ?", team.TeamID), q, squadListFields)
+	if err != nil {
+		return nil, err
+	}
+
+	footballers := []model.ManchesterUnitedFootballerDTO{}
+	for _, player := range squad {
+		footballers = append(footballers, model.ManchesterUnitedFootballerDTO{
+			PlayerName: player.PlayerName,
+			Age: 		player.Age,
+			Number: 	player.Number,
+			Position: 	player.Position,
+		})
+	}
+
+	manchesterUnitedSquadDTO := &model.ManchesterUnitedSquadPageDTO{
+		ManchesterUnitedPageDTO: 	model.ManchesterUnitedPageDTO[model.ManchesterUnitedFootballerDTO]{Results: len(squad), Pagination: pagination, Response: footballers},
+		SquadDepth: 				pagination.Total,
+		Footballers: 				footballers,
+	}
+
+	return manchesterUnitedSquadDTO, nil
+}
+
+
+func (s *service) GetTransfers(ctx context.Context, teamID int, filter model.TransferFilter) ([]*model.ManchesterUnitedTransferDTO, error) {
+	if filter.Direction != "" && filter.Direction != model.TransferDirectionIn && filter.Direction != model.TransferDirectionOut {
+		return nil, ErrInvalidTransferDirection
+	}
+
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.WithContext(ctx).Model(&model.Transfer{})
+	switch filter.Direction {
+	case model.TransferDirectionIn:
+		query = query.Where("team_in_id = ?", team.TeamID)
+	case model.TransferDirectionOut:
+		query = query.Where("team_out_id = ?", team.TeamID)
+	default:
+		query = query.Where("team_in_id = ? OR team_out_id = ?", team.TeamID, team.TeamID)
+	}
+
+	if filter.Season != 0 {
+		from, to := transferWindow(filter.Season)
+		query = query.Where("date >= ? AND date < ?", from, to)
+	}
+	if filter.PlayerID != 0 {
+		query = query.Where("player_id = ?", filter.PlayerID)
+	}
+
+	var transfers []model.Transfer
+	if err := query.Order("date DESC").Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+
+	var squadPlayerIDs []int
+	if err := s.db.WithContext(ctx).Model(&model.Squad{}).Where("team_id = ?", team.TeamID).Pluck("player_id", &squadPlayerIDs).Error; err != nil {
+		return nil, err
+	}
+
+	inSquad := make(map[int]bool, len(squadPlayerIDs))
+	for _, id := range squadPlayerIDs {
+		inSquad[id] = true
+	}
+
+	manchesterUnitedTransfersDTO := []*model.ManchesterUnitedTransferDTO{}
+	for _, t := range transfers {
+		direction := model.TransferDirectionOut
+		if t.TeamInID == team.TeamID {
+			direction = model.TransferDirectionIn
+		}
+
+		manchesterUnitedTransfersDTO = append(manchesterUnitedTransfersDTO, &model.ManchesterUnitedTransferDTO{
+			PlayerID: 		t.PlayerID,
+			PlayerName: 	t.PlayerName,
+			Date: 			t.Date,
+			Season: 		transferSeason(t.Date),
+			Type: 			t.Type,
+			Direction: 		direction,
+			FromTeam: 		t.TeamOutName,
+			ToTeam: 		t.TeamInName,
+			InSquad: 		inSquad[t.PlayerID],
+		})
+	}
+
+	return manchesterUnitedTransfersDTO, nil
+}
+
+
+func (s *service) GetCoaches(ctx context.Context, teamID int) ([]*model.ManchesterUnitedCoachDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var careers []model.CoachCareer
+	if err := s.db.WithContext(ctx).Where("team_id = ?", team.TeamID).Order("start DESC").Find(&careers).Error; err != nil {
+		return nil, err
+	}
+
+	tenures := make(map[int][]model.CoachCareer)
+	var coachIDs []int
+	for _, c := range careers {
+		if _, ok := tenures[c.CoachID]; !ok {
+			coachIDs = append(coachIDs, c.CoachID)
+		}
+		tenures[c.CoachID] = append(tenures[c.CoachID], c)
+	}
+
+	var coaches []model.Coach
+	if len(coachIDs) > 0 {
+		if err := s.db.WithContext(ctx).Where("coach_id IN ?", coachIDs).Find(&coaches).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	coachByID := make(map[int]model.Coach, len(coaches))
+	for _, c := range coaches {
+		coachByID[c.CoachID] = c
+	}
+
+	fixtures, err := s.finishedFixtures(ctx, team.TeamID, team.LeagueID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	manchesterUnitedCoachesDTO := []*model.ManchesterUnitedCoachDTO{}
+	for _, coachID := range coachIDs {
+		stints := tenures[coachID]
+		coach := coachByID[coachID]
+
+		// stints are newest first, so the tenure runs from the start of the
+		// last one to the end of the first one
+		manchesterUnitedCoachesDTO = append(manchesterUnitedCoachesDTO, &model.ManchesterUnitedCoachDTO{
+			CoachID: 		coachID,
+			Name: 			coach.Name,
+			Nationality: 	coach.Nationality,
+			Start: 			stints[len(stints) - 1].Start,
+			End: 			stints[0].End,
+			Current: 		stints[0].End == "",
+			Record: 		tenureRecord(inTenure(fixtures, stints), team.TeamID),
+		})
+	}
+
+	return manchesterUnitedCoachesDTO, nil
+}
+
+
+func (s *service) GetCoach(ctx context.Context, teamID, coachID int) (*model.ManchesterUnitedCoachProfileDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var coach model.Coach
+	if err := s.db.WithContext(ctx).Where("coach_id = ?", coachID).First(&coach).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCoachNotFound
+		}
+		return nil, err
+	}
+
+	var careers []model.CoachCareer
+	if err := s.db.WithContext(ctx).Where("coach_id = ?", coachID).Order("start DESC").Find(&careers).Error; err != nil {
+		return nil, err
+	}
+
+	manchesterUnitedCoachProfileDTO := &model.ManchesterUnitedCoachProfileDTO{
+		CoachID: 		coach.CoachID,
+		Name: 			coach.Name,
+		Firstname: 		coach.Firstname,
+		Lastname: 		coach.Lastname,
+		Age: 			coach.Age,
+		BirthDate: 		coach.BirthDate,
+		Nationality: 	coach.Nationality,
+		Career: 		[]model.ManchesterUnitedCoachCareerDTO{},
+		Seasons: 		[]model.ManchesterUnitedTenureSeasonDTO{},
+	}
+
+	var stints []model.CoachCareer
+	for _, c := range careers {
+		manchesterUnitedCoachProfileDTO.Career = append(manchesterUnitedCoachProfileDTO.Career, model.ManchesterUnitedCoachCareerDTO{
+			TeamName: 	c.TeamName,
+			Start: 		c.Start,
+			End: 		c.End,
+		})
+		if c.TeamID == team.TeamID {
+			stints = append(stints, c)
+		}
+	}
+
+	if len(stints) == 0 {
+		return manchesterUnitedCoachProfileDTO, nil
+	}
+
+	fixtures, err := s.finishedFixtures(ctx, team.TeamID, team.LeagueID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures = inTenure(fixtures, stints)
+	manchesterUnitedCoachProfileDTO.Record = tenureRecord(fixtures, team.TeamID)
+
+	bySeason := make(map[int][]model.Fixture)
+	var seasons []int
+	for _, f := range fixtures {
+		if _, ok := bySeason[f.Season]; !ok {
+			seasons = append(seasons, f.Season)
+		}
+		bySeason[f.Season] = append(bySeason[f.Season], f)
+	}
+
+	for _, season := range seasons {
+		manchesterUnitedCoachProfileDTO.Seasons = append(manchesterUnitedCoachProfileDTO.Seasons, model.ManchesterUnitedTenureSeasonDTO{
+			Season: 	season,
+			Record: 	tenureRecord(bySeason[season], team.TeamID),
+		})
+	}
+
+	return manchesterUnitedCoachProfileDTO, nil
+}
+
+
+func (s *service) GetPlayerTrophies(ctx context.Context, playerID int) (*model.ManchesterUnitedPlayerTrophiesDTO, error) {
+	var trophies []model.Trophy
+	if err := s.db.WithContext(ctx).Where("player_id = ? AND coach_id = 0", playerID).Order("season DESC, league").Find(&trophies).Error; err != nil {
+		return nil, err
+	}
+
+	if len(trophies) == 0 {
+		return nil, ErrPlayerTrophiesNotFound
+	}
+
+	manchesterUnitedPlayerTrophiesDTO := &model.ManchesterUnitedPlayerTrophiesDTO{PlayerID: playerID}
+	for _, t := range trophies {
+		if isTitle(t.Place) {
+			manchesterUnitedPlayerTrophiesDTO.Titles++
+		}
+		manchesterUnitedPlayerTrophiesDTO.Trophies = append(manchesterUnitedPlayerTrophiesDTO.Trophies, model.ManchesterUnitedTrophyDTO{
+			League: 	t.League,
+			Country: 	t.Country,
+			Season: 	t.Season,
+			Place: 		t.Place,
+		})
+	}
+
+	return manchesterUnitedPlayerTrophiesDTO, nil
+}
+
+
+func (s *service) GetPlayerSidelined(ctx context.Context, playerID int) (*model.ManchesterUnitedPlayerSidelinedDTO, error) {
+	var periods []model.Sidelined
+	if err := s.db.WithContext(ctx).Where("player_id = ?", playerID).Order("start DESC").Find(&periods).Error; err != nil {
+		return nil, err
+	}
+
+	if len(periods) == 0 {
+		return nil, ErrPlayerSidelinedNotFound
+	}
+
+	now := time.Now()
+	manchesterUnitedPlayerSidelinedDTO := &model.ManchesterUnitedPlayerSidelinedDTO{PlayerID: playerID}
+	for _, p := range periods {
+		days := sidelinedDays(p.Start, p.End, now)
+		manchesterUnitedPlayerSidelinedDTO.DaysOut += days
+		manchesterUnitedPlayerSidelinedDTO.Periods = append(manchesterUnitedPlayerSidelinedDTO.Periods, model.ManchesterUnitedSidelinedDTO{
+			Type: 		p.Type,
+			Start: 		p.Start,
+			End: 		p.End,
+			Days: 		days,
+			Ongoing: 	p.End == "",
+		})
+	}
+
+	return manchesterUnitedPlayerSidelinedDTO, nil
+}
+
+
+// GetTeamTrophies credits the club with the trophies its coaches won during
+// their tenure, since API-Football only serves trophies per player or coach.
+func (s *service) GetTeamTrophies(ctx context.Context, teamID int) (*model.ManchesterUnitedClubTrophiesDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var careers []model.CoachCareer
+	if err := s.db.WithContext(ctx).Where("team_id = ?", team.TeamID).Find(&careers).Error; err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	var coachIDs []int
+	for _, c := range careers {
+		if !seen[c.CoachID] {
+			seen[c.CoachID] = true
+			coachIDs = append(coachIDs, c.CoachID)
+		}
+	}
+
+	// every club of the coaches, a trophy won elsewhere in the same season
+	// must not be credited to the team
+	tenures := make(map[int][]model.CoachCareer)
+	var trophies []model.Trophy
+	var coaches []model.Coach
+	if len(coachIDs) > 0 {
+		var allCareers []model.CoachCareer
+		if err := s.db.WithContext(ctx).Where("coach_id IN ?", coachIDs).Find(&allCareers).Error; err != nil {
+			return nil, err
+		}
+		for _, c := range allCareers {
+			tenures[c.CoachID] = append(tenures[c.CoachID], c)
+		}
+
+		if err := s.db.WithContext(ctx).Where("coach_id IN ?", coachIDs).Order("season DESC, league").Find(&trophies).Error; err != nil {
+			return nil, err
+		}
+		if err := s.db.WithContext(ctx).Where("coach_id IN ?", coachIDs).Find(&coaches).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if len(trophies) == 0 {
+		return nil, ErrTeamTrophiesNotFound
+	}
+
+	coachNames := make(map[int]string, len(coaches))
+	for _, c := range coaches {
+		coachNames[c.CoachID] = c.Name
+	}
+
+	manchesterUnitedClubTrophiesDTO := &model.ManchesterUnitedClubTrophiesDTO{
+		TeamName: 		team.TeamName,
+		Attribution: 	model.ClubTrophiesAttribution,
+		Trophies: 		[]model.ManchesterUnitedClubTrophyDTO{},
+	}
+
+	for _, t := range trophies {
+		start, end, ok := trophySeasonSpan(t.Season)
+		if !ok || trophyClub(tenures[t.CoachID], start, end) != team.TeamID {
+			continue
+		}
+
+		if isTitle(t.Place) {
+			manchesterUnitedClubTrophiesDTO.Titles++
+		}
+		manchesterUnitedClubTrophiesDTO.Trophies = append(manchesterUnitedClubTrophiesDTO.Trophies, model.ManchesterUnitedClubTrophyDTO{
+			League: 	t.League,
+			Country: 	t.Country,
+			Season: 	t.Season,
+			Place: 		t.Place,
+			CoachName: 	coachNames[t.CoachID],
+		})
+	}
+
+	return manchesterUnitedClubTrophiesDTO, nil
+}
+
+
+func (s *service) GetHeadToHead(ctx context.Context, teamID, opponentID, last int) (*model.ManchesterUnitedHeadToHeadDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := s.meetings(ctx, team.TeamID, opponentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fixtures) == 0 {
+		return nil, ErrHeadToHeadNotFound
+	}
+
+	var home, away []model.Fixture
+	for _, f := range fixtures {
+		if f.HomeTeamID == team.TeamID {
+			home = append(home, f)
+		} else {
+			away = append(away, f)
+		}
+	}
+
+	opponentName := fixtures[0].HomeTeamName
+	if fixtures[0].HomeTeamID == team.TeamID {
+		opponentName = fixtures[0].AwayTeamName
+	}
+
+	manchesterUnitedHeadToHeadDTO := &model.ManchesterUnitedHeadToHeadDTO{
+		TeamName: 		team.TeamName,
+		OpponentName: 	opponentName,
+		Total: 			tenureRecord(fixtures, team.TeamID),
+		Home: 			tenureRecord(home, team.TeamID),
+		Away: 			tenureRecord(away, team.TeamID),
+		LastMeetings: 	[]model.ManchesterUnitedMeetingDTO{},
+	}
+
+	var biggestWin, biggestLoss *model.Fixture
+	for i, f := range fixtures {
+		if i < last {
+			manchesterUnitedHeadToHeadDTO.LastMeetings = append(manchesterUnitedHeadToHeadDTO.LastMeetings, meeting(f, team.TeamID))
+		}
+
+		// fixtures are newest first and only a strictly bigger win replaces
+		// the current pick, so ties go to the most recent meeting
+		scored, conceded := goalsFor(f, team.TeamID)
+		if scored > conceded && (biggestWin == nil || biggerMargin(f, *biggestWin, team.TeamID)) {
+			biggestWin = &fixtures[i]
+		}
+		if conceded > scored && (biggestLoss == nil || biggerMargin(f, *biggestLoss, opponentID)) {
+			biggestLoss = &fixtures[i]
+		}
+	}
+
+	if biggestWin != nil {
+		win := meeting(*biggestWin, team.TeamID)
+		manchesterUnitedHeadToHeadDTO.BiggestWin = &win
+	}
+	if biggestLoss != nil {
+		loss := meeting(*biggestLoss, team.TeamID)
+		manchesterUnitedHeadToHeadDTO.BiggestLoss = &loss
+	}
+
+	return manchesterUnitedHeadToHeadDTO, nil
+}
+
+
+func (s *service) GetAPIQuota(ctx context.Context) (*model.APIQuotaDTO, error) {
+	var quota model.APIQuota
+	if err := s.db.WithContext(ctx).First(&quota).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrQuotaNotRecorded
+		}
+		return nil, err
+	}
+
+	return &model.APIQuotaDTO{
+		DailyLimit: 		quota.DailyLimit,
+		DailyRemaining: 	quota.DailyRemaining,
+		MinuteLimit: 		quota.MinuteLimit,
+		MinuteRemaining: 	quota.MinuteRemaining,
+		DailyUpdatedAt: 	quota.DailyUpdatedAt,
+		UpdatedAt: 			quota.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package service_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+	"github.com/deikioveca/TheRedDevilsData/api/service"
+	"gorm.io/gorm"
+)
+
+
+// storedSeason imports one season of fixtures from the mock provider and
+// returns them in kick-off order, the oracle the list tests compare against.
+func storedSeason(t *testing.T) (service.Service, *gorm.DB, []model.Fixture) {
+	t.Helper()
+
+	db := openTestDB(t, "fixtures", "coach_careers")
+	svc, _ := newTestService(t, db)
+
+	if _, err := svc.SaveFixtures(context.Background(), 0, []int{2023}); err != nil {
+		t.Fatalf("SaveFixtures() error = %v", err)
+	}
+
+	var fixtures []model.Fixture
+	if err := db.Order("timestamp").Order("id").Find(&fixtures).Error; err != nil {
+		t.Fatalf("failed to read fixtures: %v", err)
+	}
+	if len(fixtures) < 20 {
+		t.Fatalf("stored %d fixtures, want a full season", len(fixtures))
+	}
+
+	return svc, db, fixtures
+}
+
+
+func pageIDs(page *model.ManchesterUnitedPageDTO[*model.ManchesterUnitedFixturesDTO]) []int {
+	ids := make([]int, len(page.Response))
+	for i, f := range page.Response {
+		ids[i] = f.FixtureID
+	}
+	return ids
+}
+
+
+func fixtureIDs(fixtures []model.Fixture, keep func(model.Fixture) bool) []int {
+	ids := []int{}
+	for _, f := range fixtures {
+		if keep(f) {
+			ids = append(ids, f.FixtureID)
+		}
+	}
+	return ids
+}
+
+
+func TestGetFixturesPagination(t *testing.T) {
+	svc, _, fixtures := storedSeason(t)
+	total := len(fixtures)
+	all := fixtureIDs(fixtures, func(model.Fixture) bool { return true })
+
+	next := func(offset int) *int { return &offset }
+
+	tests := []struct {
+		name		string
+		query		model.ListQuery
+		want		[]int
+		limit		int
+		nextOffset	*int
+		err			error
+	}{
+		{name: "no limit returns every row", query: model.ListQuery{}, want: all},
+		{name: "first page", query: model.ListQuery{Limit: 10}, want: all[:10], limit: 10, nextOffset: next(10)},
+		{name: "middle page", query: model.ListQuery{Limit: 10, Offset: 10}, want: all[10:20], limit: 10, nextOffset: next(20)},
+		{name: "last page", query: model.ListQuery{Limit: 10, Offset: total - 5}, want: all[total - 5:], limit: 10},
+		{name: "offset past the end", query: model.ListQuery{Limit: 10, Offset: total + 10}, want: []int{}, limit: 10},
+		{name: "limit is capped", query: model.ListQuery{Limit: 100000}, want: all, limit: 500},
+		{name: "offset without limit", query: model.ListQuery{Offset: total - 3}, want: all[total - 3:]},
+		{name: "sort descending", query: model.ListQuery{Sort: []string{"-date"}, Limit: 1}, want: all[total - 1:], limit: 1, nextOffset: next(1)},
+		{name: "unknown sort", query: model.ListQuery{Sort: []string{"goals"}}, err: service.ErrInvalidListSort},
+		{name: "unknown filter", query: model.ListQuery{Filters: map[string][]string{"goals": {"3"}}}, err: service.ErrInvalidListFilter},
+		{name: "numeric filter", query: model.ListQuery{Filters: map[string][]string{"league": {"39"}}}, want: all},
+		{name: "numeric filter without a match", query: model.ListQuery{Filters: map[string][]string{"league": {"2"}}}, want: []int{}},
+		{name: "numeric filter with text", query: model.ListQuery{Filters: map[string][]string{"league": {"premier"}}}, err: service.ErrInvalidListFilter},
+		{
+			name: 	"text filter with several values",
+			query: 	model.ListQuery{Filters: map[string][]string{"venue_name": {fixtures[0].VenueName, fixtures[1].VenueName}}},
+			want: 	fixtureIDs(fixtures, func(f model.Fixture) bool { return f.VenueName == fixtures[0].VenueName || f.VenueName == fixtures[1].VenueName }),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := svc.GetFixtures(context.Background(), 0, model.FixtureFilter{}, tt.query)
+			if tt.err != nil {
+				if err != tt.err {
+					t.Fatalf("GetFixtures() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetFixtures() error = %v", err)
+			}
+
+			if got := pageIDs(page); !slices.Equal(got, tt.want) {
+				t.Errorf("GetFixtures() fixtures = %v, want %v", got, tt.want)
+			}
+			if page.Results != len(tt.want) {
+				t.Errorf("results = %d, want %d", page.Results, len(tt.want))
+			}
+
+			p := page.Pagination
+			wantTotal := int64(total)
+			if tt.query.Filters != nil {
+				wantTotal = int64(len(tt.want))
+			}
+			if p.Total != wantTotal || p.Limit != tt.limit || p.Offset != tt.query.Offset {
+				t.Errorf("pagination = total %d, limit %d, offset %d, want %d, %d, %d", p.Total, p.Limit, p.Offset, wantTotal, tt.limit, tt.query.Offset)
+			}
+			if (p.NextOffset == nil) != (tt.nextOffset == nil) || (p.NextOffset != nil && *p.NextOffset != *tt.nextOffset) {
+				t.Errorf("next offset = %v, want %v", p.NextOffset, tt.nextOffset)
+			}
+		})
+	}
+}
+
+
+func TestGetFixturesFilter(t *testing.T) {
+	svc, db, fixtures := storedSeason(t)
+	teamID := model.DefaultTrackedTeam.TeamID
+
+	opponentID := fixtures[0].AwayTeamID
+	if opponentID == teamID {
+		opponentID = fixtures[0].HomeTeamID
+	}
+
+	day := func(f model.Fixture) time.Time {
+		return time.Unix(f.Timestamp, 0).UTC().Truncate(24 * time.Hour)
+	}
+	from, to := day(fixtures[4]), day(fixtures[9])
+
+	tenure := model.CoachCareer{CoachID: 900, TeamID: teamID, Start: fixtures[3].Date[:10], End: fixtures[12].Date[:10]}
+	if err := db.Create(&tenure).Error; err != nil {
+		t.Fatalf("failed to store a coach tenure: %v", err)
+	}
+
+	finished := func(f model.Fixture) bool {
+		return f.StatusShort == "FT" || f.StatusShort == "AET" || f.StatusShort == "PEN"
+	}
+	scored := func(f model.Fixture) (int, int) {
+		if f.HomeTeamID == teamID {
+			return f.GoalsHome, f.GoalsAway
+		}
+		return f.GoalsAway, f.GoalsHome
+	}
+
+	tests := []struct {
+		name	string
+		filter	model.FixtureFilter
+		keep	func(model.Fixture) bool
+		err		error
+	}{
+		{"season", model.FixtureFilter{Season: 2023}, func(model.Fixture) bool { return true }, nil},
+		{"other season", model.FixtureFilter{Season: 2022}, func(model.Fixture) bool { return false }, nil},
+		{"home", model.FixtureFilter{Venue: model.FixtureVenueHome}, func(f model.Fixture) bool { return f.HomeTeamID == teamID }, nil},
+		{"away", model.FixtureFilter{Venue: model.FixtureVenueAway}, func(f model.Fixture) bool { return f.AwayTeamID == teamID }, nil},
+		{"unknown venue", model.FixtureFilter{Venue: "neutral"}, nil, service.ErrInvalidFixtureVenue},
+		{"wins", model.FixtureFilter{Result: "W"}, func(f model.Fixture) bool { us, them := scored(f); return finished(f) && us > them }, nil},
+		{"draws", model.FixtureFilter{Result: "d"}, func(f model.Fixture) bool { us, them := scored(f); return finished(f) && us == them }, nil},
+		{"losses", model.FixtureFilter{Result: "L"}, func(f model.Fixture) bool { us, them := scored(f); return finished(f) && us < them }, nil},
+		{"unknown result", model.FixtureFilter{Result: "X"}, nil, service.ErrInvalidFixtureResult},
+		{"round number", model.FixtureFilter{Round: "1"}, func(f model.Fixture) bool { return strings.HasSuffix(f.Round, " - 1") }, nil},
+		{"round label", model.FixtureFilter{Round: fixtures[0].Round}, func(f model.Fixture) bool { return f.Round == fixtures[0].Round }, nil},
+		{"opponent", model.FixtureFilter{OpponentID: opponentID}, func(f model.Fixture) bool { return f.HomeTeamID == opponentID || f.AwayTeamID == opponentID }, nil},
+		{"opponent at home", model.FixtureFilter{OpponentID: opponentID, Venue: model.FixtureVenueHome}, func(f model.Fixture) bool { return f.HomeTeamID == teamID && f.AwayTeamID == opponentID }, nil},
+		{"from", model.FixtureFilter{From: from}, func(f model.Fixture) bool { return !day(f).Before(from) }, nil},
+		{"from and to", model.FixtureFilter{From: from, To: to}, func(f model.Fixture) bool { return !day(f).Before(from) && !day(f).After(to) }, nil},
+		{"coach tenure", model.FixtureFilter{CoachID: tenure.CoachID}, func(f model.Fixture) bool { return f.Date[:10] >= tenure.Start && f.Date[:10] <= tenure.End }, nil},
+		{"coach without a tenure", model.FixtureFilter{CoachID: 901}, nil, service.ErrCoachTenureNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := svc.GetFixtures(context.Background(), 0, tt.filter, model.ListQuery{})
+			if tt.err != nil {
+				if err != tt.err {
+					t.Fatalf("GetFixtures() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetFixtures() error = %v", err)
+			}
+
+			if got, want := pageIDs(page), fixtureIDs(fixtures, tt.keep); !slices.Equal(got, want) {
+				t.Errorf("GetFixtures() fixtures = %v, want %v", got, want)
+			}
+		})
+	}
+}
\ No newline at end of file
@@ -8,6 +8,7 @@ import (
 type Service interface {
 	DataImporter
 	DataProvider
+	TeamRegistry
 }
 
 type service struct {
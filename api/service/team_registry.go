@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deikioveca/TheRedDevilsData/api/model"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTeamNotTracked = errors.New("team is not tracked")
+
+	ErrInvalidTrackedTeam = errors.New("tracked team requires team id and league id")
+)
+
+
+type TeamRegistry interface {
+	GetTrackedTeam(ctx context.Context, teamID int) (*model.TrackedTeamDTO, error)
+	GetTrackedTeams(ctx context.Context) ([]*model.TrackedTeamDTO, error)
+	TrackTeam(ctx context.Context, team model.TrackedTeamDTO) (*model.TrackedTeamDTO, error)
+}
+
+
+func toTrackedTeamDTO(t model.TrackedTeam) *model.TrackedTeamDTO {
+	return &model.TrackedTeamDTO{
+		TeamID: 		t.TeamID,
+		TeamName: 		t.TeamName,
+		LeagueID: 		t.LeagueID,
+		LeagueName: 	t.LeagueName,
+		Country: 		t.Country,
+		IsDefault: 		t.IsDefault,
+	}
+}
+
+
+func (s *service) trackedTeam(ctx context.Context, teamID int) (*model.TrackedTeam, error) {
+	query := s.db.WithContext(ctx).Where("team_id = ?", teamID)
+	if teamID == 0 {
+		query = s.db.WithContext(ctx).Where("is_default = ?", true)
+	}
+
+	var team model.TrackedTeam
+	if err := query.First(&team).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTeamNotTracked
+		}
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+
+// GetTrackedTeam resolves a team from the registry, 0 means the default team.
+func (s *service) GetTrackedTeam(ctx context.Context, teamID int) (*model.TrackedTeamDTO, error) {
+	team, err := s.trackedTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return toTrackedTeamDTO(*team), nil
+}
+
+
+func (s *service) GetTrackedTeams(ctx context.Context) ([]*model.TrackedTeamDTO, error) {
+	var teams []model.TrackedTeam
+	if err := s.db.WithContext(ctx).Order("team_id").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+
+	trackedTeams := []*model.TrackedTeamDTO{}
+	for _, t := range teams {
+		trackedTeams = append(trackedTeams, toTrackedTeamDTO(t))
+	}
+
+	return trackedTeams, nil
+}
+
+
+func (s *service) TrackTeam(ctx context.Context, dto model.TrackedTeamDTO) (*model.TrackedTeamDTO, error) {
+	if dto.TeamID <= 0 || dto.LeagueID <= 0 {
+		return nil, ErrInvalidTrackedTeam
+	}
+
+	team := model.TrackedTeam{
+		TeamID: 		dto.TeamID,
+		TeamName: 		dto.TeamName,
+		LeagueID: 		dto.LeagueID,
+		LeagueName: 	dto.LeagueName,
+		Country: 		dto.Country,
+		IsDefault: 		dto.IsDefault,
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if team.IsDefault {
+			if err := tx.Model(&model.TrackedTeam{}).Where("is_default = ?", true).Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+
+		var existing model.TrackedTeam
+		err := tx.Where("team_id = ?", team.TeamID).First(&existing).Error
+		switch {
+		case err == nil:
+			// the default only moves by making another team default, re-posting
+			// it without is_default must not leave the registry without one
+			team.ID = existing.ID
+			team.IsDefault = team.IsDefault || existing.IsDefault
+			return tx.Save(&team).Error
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return tx.Create(&team).Error
+		default:
+			return err
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toTrackedTeamDTO(team), nil
+}
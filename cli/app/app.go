@@ -1,28 +1,111 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
 
 	"github.com/deikioveca/TheRedDevilsData/api/database"
 	"github.com/deikioveca/TheRedDevilsData/api/football_client"
+	"github.com/deikioveca/TheRedDevilsData/api/mock_provider"
+	"github.com/deikioveca/TheRedDevilsData/api/model"
 	"github.com/deikioveca/TheRedDevilsData/api/service"
 	"github.com/spf13/cobra"
 )
 
 
 type CLI struct {
-	Service service.Service
+	Service 		service.Service
+	teamID			int
+	cassetteMode	string
+	cassetteDir		string
+}
+
+
+type seasonFlags struct {
+	seasons	[]int
+	from	int
+	to		int
+}
+
+
+func (sf *seasonFlags) register(cmd *cobra.Command) {
+	cmd.Flags().IntSliceVar(&sf.seasons, "season", nil, "season to fetch, repeatable or comma separated (e.g. --season 2024)")
+	cmd.Flags().IntVar(&sf.from, "from", 0, "first season of a range to fetch (e.g. --from 2015 --to 2025)")
+	cmd.Flags().IntVar(&sf.to, "to", 0, "last season of a range to fetch")
+}
+
+
+func (sf *seasonFlags) resolve() ([]int, error) {
+	seasons := append([]int{}, sf.seasons...)
+
+	if sf.from != 0 || sf.to != 0 {
+		from, to := sf.from, sf.to
+		if from == 0 {
+			from = to
+		}
+		if to == 0 {
+			to = from
+		}
+
+		seasonRange, err := service.SeasonRange(from, to)
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, seasonRange...)
+	}
+
+	return service.UniqueSeasons(seasons), nil
 }
 
 
 func NewCLI() *CLI {
-	db 		:= database.InitDB()
-	client 	:= football_client.NewFootballClient(db)
-	service := service.NewService(db, client)
+	return &CLI{}
+}
+
+
+func (c *CLI) init(cmd *cobra.Command, args []string) error {
+	var mode football_client.CassetteMode
+	if c.cassetteMode != "" {
+		parsed, err := football_client.ParseCassetteMode(c.cassetteMode)
+		if err != nil {
+			return err
+		}
+		mode = parsed
+	}
+
+	db 				:= database.InitDB()
+	client, err 	:= football_client.NewFootballClient(db, football_client.WithCassette(mode, c.cassetteDir))
+	if err != nil {
+		return err
+	}
+
+	c.Service = service.NewService(db, client)
+	return nil
+}
 
-	return &CLI{
-		Service: service,
+
+// trackedTeam resolves --team for command output, names the registry was given
+// without fall back to their ids.
+func (c *CLI) trackedTeam(ctx context.Context) (*model.TrackedTeamDTO, error) {
+	team, err := c.Service.GetTrackedTeam(ctx, c.teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if team.TeamName == "" {
+		team.TeamName = fmt.Sprintf("team %d", team.TeamID)
 	}
+	if team.LeagueName == "" {
+		team.LeagueName = fmt.Sprintf("league %d", team.LeagueID)
+	}
+
+	return team, nil
 }
 
 
@@ -30,8 +113,13 @@ func (c *CLI) RootCmd() *cobra.Command {
 	root := cobra.Command{
 		Use: 	"football-cli",
 		Short: 	"CLI for fetching and saving football data",
+		PersistentPreRunE: c.init,
 	}
 
+	root.PersistentFlags().IntVar(&c.teamID, "team", 0, "API-Football team ID from the tracked teams registry (defaults to the registry default)")
+	root.PersistentFlags().StringVar(&c.cassetteMode, "cassette", "", "record or replay API-Football responses: live, record, replay (defaults to FOOTBALL_CASSETTE_MODE)")
+	root.PersistentFlags().StringVar(&c.cassetteDir, "cassette-dir", "", "directory for recorded responses (defaults to FOOTBALL_CASSETTE_DIR or ./cassettes)")
+
 	root.AddCommand(c.FetchCountriesCmd())
 	root.AddCommand(c.FetchAllLeaguesForTeam())
 	root.AddCommand(c.FetchTeam())
@@ -39,8 +127,24 @@ func (c *CLI) RootCmd() *cobra.Command {
 	root.AddCommand(c.FetchVenues())
 	root.AddCommand(c.FetchStandings())
 	root.AddCommand(c.FetchFixtures())
+	root.AddCommand(c.FetchLeagueFixtures())
 	root.AddCommand(c.FetchInjuries())
 	root.AddCommand(c.FetchSquad())
+	root.AddCommand(c.FetchFixtureEvents())
+	root.AddCommand(c.FetchFixtureLineups())
+	root.AddCommand(c.FetchFixtureStatistics())
+	root.AddCommand(c.FetchPlayerStats())
+	root.AddCommand(c.FetchLeaderboards())
+	root.AddCommand(c.Leaderboard())
+	root.AddCommand(c.FetchTransfers())
+	root.AddCommand(c.FetchCoaches())
+	root.AddCommand(c.FetchTrophies())
+	root.AddCommand(c.FetchSidelined())
+	root.AddCommand(c.FetchHeadToHead())
+	root.AddCommand(c.ListTrackedTeams())
+	root.AddCommand(c.TrackTeam())
+	root.AddCommand(c.Quota())
+	root.AddCommand(c.MockProvider())
 
 	return &root
 }
@@ -51,7 +155,7 @@ func (c *CLI) FetchCountriesCmd() *cobra.Command {
 		Use: "fetch-countries",
 		Short: "Fetch countries from API-Football and save them to DB",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			countries, err := c.Service.SaveCountries()
+			countries, err := c.Service.SaveCountries(cmd.Context())
 			if err != nil {
 				return err
 			}
@@ -68,13 +172,18 @@ func (c *CLI) FetchCountriesCmd() *cobra.Command {
 func (c *CLI) FetchAllLeaguesForTeam() *cobra.Command {
 	return &cobra.Command{
 		Use: "fetch-all-leagues-for-team",
-		Short: "Fetch all leagues in which Manchester United has played at least one match",
+		Short: "Fetch all leagues in which the team has played at least one match",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			leagues, err := c.Service.SaveLeaguesForTeam()
+			leagues, err := c.Service.SaveLeaguesForTeam(cmd.Context(), c.teamID)
 			if err != nil {
 				return err
 			}
-			fmt.Printf("Saved %d leagues for Manchester United\n", leagues.Results)
+
+			team, err := c.trackedTeam(cmd.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Saved %d leagues for %s\n", leagues.Results, team.TeamName)
 			return nil
 		},
 	}
@@ -84,9 +193,9 @@ func (c *CLI) FetchAllLeaguesForTeam() *cobra.Command {
 func (c *CLI) FetchTeam() *cobra.Command {
 	return &cobra.Command{
 		Use: "fetch-team",
-		Short: "Fetch manchester united from api-football",
+		Short: "Fetch the team from api-football",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			team, err := c.Service.SaveTeam()
+			team, err := c.Service.SaveTeam(cmd.Context(), c.teamID)
 			if err != nil {
 				return err
 			}
@@ -98,11 +207,18 @@ func (c *CLI) FetchTeam() *cobra.Command {
 
 
 func (c *CLI) FetchTeamStats() *cobra.Command {
-	return &cobra.Command{
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
 		Use: "fetch-team-stats",
-		Short: "Fetch and save manchester united stats for seasons: 2021, 2022, 2023",
+		Short: "Fetch and save team stats for the selected seasons (default: 2021, 2022, 2023)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stats, err := c.Service.SaveTeamStats()
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			stats, err := c.Service.SaveTeamStats(cmd.Context(), c.teamID, seasons)
 			if err != nil {
 				return err
 			}
@@ -115,20 +231,28 @@ func (c *CLI) FetchTeamStats() *cobra.Command {
 			return nil
 		},
 	}
+
+	sf.register(cmd)
+
+	return cmd
 }
 
 
 func (c *CLI) FetchVenues() *cobra.Command {
 	return &cobra.Command{
 		Use: "fetch-venues",
-		Short: "Fetch and save all venues from england",
+		Short: "Fetch and save all venues from the team's country",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			venues, err := c.Service.SaveVenues()
+			venues, err := c.Service.SaveVenues(cmd.Context(), c.teamID)
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("Successfully saved %d venues from england.\n", venues.Results)
+			team, err := c.trackedTeam(cmd.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Successfully saved %d venues from %s.\n", venues.Results, team.Country)
 			return nil
 		},
 	}
@@ -136,62 +260,136 @@ func (c *CLI) FetchVenues() *cobra.Command {
 
 
 func (c *CLI) FetchStandings() *cobra.Command {
-	return &cobra.Command{
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
 		Use: "fetch-standings",
-		Short: "Fetch and save manchester united standings for season 2021, 2022, 2023",
+		Short: "Fetch and save standings for the selected seasons (default: 2021, 2022, 2023)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			standings, err := c.Service.SaveStandings()
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			standings, err := c.Service.SaveStandings(cmd.Context(), c.teamID, seasons)
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("Successfully saved %d season standings for Manchester United.\n", len(standings))
+			fmt.Printf("Successfully saved %d season league tables.\n", len(standings))
 			return nil
 		},
 	}
+
+	sf.register(cmd)
+
+	return cmd
 }
 
 
 func (c *CLI) FetchFixtures() *cobra.Command {
-	return &cobra.Command{
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
 		Use: "fetch-fixtures",
-		Short: "Fetch and save all manchester united fixtures in the premier league for 3 seasons 2021, 2022, 2023",
+		Short: "Fetch and save all team fixtures in the tracked league for the selected seasons (default: 2021, 2022, 2023)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fixtures, err := c.Service.SaveFixtures()
+			seasons, err := sf.resolve()
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("Successfully saved %d season fixtures in the premier league for Manchester United.\n", len(fixtures))
+			fixtures, err := c.Service.SaveFixtures(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			team, err := c.trackedTeam(cmd.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Successfully saved %d season fixtures in the %s for %s.\n", len(fixtures), team.LeagueName, team.TeamName)
 			return nil
 		},
 	}
+
+	sf.register(cmd)
+
+	return cmd
+}
+
+
+func (c *CLI) FetchLeagueFixtures() *cobra.Command {
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
+		Use: "fetch-league-fixtures",
+		Short: "Fetch and save every fixture of the tracked league for the selected seasons (default: 2021, 2022, 2023)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			fixtures, err := c.Service.SaveLeagueFixtures(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Successfully saved %d seasons of league fixtures.\n", len(fixtures))
+			return nil
+		},
+	}
+
+	sf.register(cmd)
+
+	return cmd
 }
 
 
 func (c *CLI) FetchInjuries() *cobra.Command {
-	return &cobra.Command{
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
 		Use: "fetch-injuries",
-		Short: "Fetch and save all manchester united injuries for 3 seasons 2021, 2022, 2023",
+		Short: "Fetch and save all team injuries for the selected seasons (default: 2021, 2022, 2023)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			injuries, err := c.Service.SaveInjuries()
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			injuries, err := c.Service.SaveInjuries(cmd.Context(), c.teamID, seasons)
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("Successfully saved %d season injuries for Manchester United.\n", len(injuries))
+			team, err := c.trackedTeam(cmd.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Successfully saved %d season injuries for %s.\n", len(injuries), team.TeamName)
 			return nil
 		},
 	}
+
+	sf.register(cmd)
+
+	return cmd
 }
 
 
 func (c *CLI) FetchSquad() *cobra.Command {
 	return &cobra.Command{
 		Use: "fetch-squad",
-		Short: "Fetch and save Manchester United squad for season 2025",
+		Short: "Fetch and save the team's current squad",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			squad, err := c.Service.SaveSquad()
+			squad, err := c.Service.SaveSquad(cmd.Context(), c.teamID)
+			if err != nil {
+				return err
+			}
+
+			team, err := c.trackedTeam(cmd.Context())
 			if err != nil {
 				return err
 			}
@@ -200,10 +398,412 @@ func (c *CLI) FetchSquad() *cobra.Command {
 				for _, player := range dto.Players {
 					fmt.Printf("%s: %s.\n", player.Name, player.Position)
 				}
-				fmt.Printf("Successfully saved %d Manchester United players.\n", len(dto.Players))
+				fmt.Printf("Successfully saved %d %s players.\n", len(dto.Players), team.TeamName)
+			}
+
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) FetchFixtureEvents() *cobra.Command {
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
+		Use: "fetch-fixture-events",
+		Short: "Fetch and save goals, cards, substitutions and VAR events for every stored team fixture in the selected seasons (default: 2021, 2022, 2023)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			events, err := c.Service.SaveFixtureEvents(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			total := 0
+			for _, fixtureEvents := range events {
+				total += len(fixtureEvents.Response)
+			}
+
+			fmt.Printf("Successfully saved %d events for %d fixtures.\n", total, len(events))
+			return nil
+		},
+	}
+
+	sf.register(cmd)
+
+	return cmd
+}
+
+
+func (c *CLI) FetchFixtureLineups() *cobra.Command {
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
+		Use: "fetch-fixture-lineups",
+		Short: "Fetch and save starting XI, bench, coach and formation for every stored team fixture in the selected seasons (default: 2021, 2022, 2023)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			lineups, err := c.Service.SaveFixtureLineups(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Successfully saved lineups for %d fixtures.\n", len(lineups))
+			return nil
+		},
+	}
+
+	sf.register(cmd)
+
+	return cmd
+}
+
+
+func (c *CLI) FetchFixtureStatistics() *cobra.Command {
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
+		Use: "fetch-fixture-statistics",
+		Short: "Fetch and save shots, possession, corners and passes for every stored team fixture in the selected seasons (default: 2021, 2022, 2023)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			statistics, err := c.Service.SaveFixtureStatistics(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Successfully saved statistics for %d fixtures.\n", len(statistics))
+			return nil
+		},
+	}
+
+	sf.register(cmd)
+
+	return cmd
+}
+
+
+func (c *CLI) FetchPlayerStats() *cobra.Command {
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
+		Use: "fetch-player-stats",
+		Short: "Fetch and save player profiles and season statistics for the team in the selected seasons (default: 2021, 2022, 2023)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			players, err := c.Service.SavePlayerStats(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			for _, seasonPlayers := range players {
+				fmt.Printf("Successfully saved statistics for %d players across %d pages.\n", len(seasonPlayers.Response), seasonPlayers.Paging.Total)
+			}
+			return nil
+		},
+	}
+
+	sf.register(cmd)
+
+	return cmd
+}
+
+
+func (c *CLI) FetchLeaderboards() *cobra.Command {
+	var sf seasonFlags
+
+	cmd := &cobra.Command{
+		Use: "fetch-leaderboards",
+		Short: "Fetch and save top scorers, assists, yellow and red cards of the tracked league for the selected seasons (default: 2021, 2022, 2023)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seasons, err := sf.resolve()
+			if err != nil {
+				return err
+			}
+
+			leaderboards, err := c.Service.SaveLeaderboards(cmd.Context(), c.teamID, seasons)
+			if err != nil {
+				return err
+			}
+
+			for _, metric := range model.LeaderboardMetrics {
+				fmt.Printf("Successfully saved %d season %s leaderboards.\n", len(leaderboards[metric]), metric)
+			}
+			return nil
+		},
+	}
+
+	sf.register(cmd)
+
+	return cmd
+}
+
+
+func (c *CLI) Leaderboard() *cobra.Command {
+	var season int
+
+	cmd := &cobra.Command{
+		Use: "leaderboard {scorers|assists|yellowcards|redcards}",
+		Short: "Print a stored league leaderboard as a ranked table, marking the tracked team's players with *",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			leaderboard, err := c.Service.GetLeaderboard(cmd.Context(), c.teamID, season, args[0])
+			if err != nil {
+				return err
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "RANK\tPLAYER\tTEAM\tVALUE\tAPPS\tMINUTES\t")
+			for _, e := range leaderboard.Entries {
+				marker := ""
+				if e.Highlighted {
+					marker = "*"
+				}
+				fmt.Fprintf(tw, "%d%s\t%s\t%s\t%d\t%d\t%d\t\n", e.Rank, marker, e.PlayerName, e.TeamName, e.Value, e.Appearances, e.Minutes)
+			}
+			return tw.Flush()
+		},
+	}
+
+	cmd.Flags().IntVar(&season, "season", service.DefaultSeasons[len(service.DefaultSeasons) - 1], "season of the leaderboard")
+
+	return cmd
+}
+
+
+func (c *CLI) FetchTransfers() *cobra.Command {
+	return &cobra.Command{
+		Use: "fetch-transfers",
+		Short: "Fetch and save the transfer history of every player who has been at the team",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			transfers, err := c.Service.SaveTransfers(cmd.Context(), c.teamID)
+			if err != nil {
+				return err
+			}
+
+			moves := 0
+			for _, dto := range transfers.Response {
+				moves += len(dto.Transfers)
+			}
+
+			fmt.Printf("Successfully saved %d transfers for %d players.\n", moves, len(transfers.Response))
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) FetchCoaches() *cobra.Command {
+	return &cobra.Command{
+		Use: "fetch-coaches",
+		Short: "Fetch and save every coach of the team with their career history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			coaches, err := c.Service.SaveCoaches(cmd.Context(), c.teamID)
+			if err != nil {
+				return err
+			}
+
+			for _, coach := range coaches.Response {
+				fmt.Printf("%s: %d career entries.\n", coach.Name, len(coach.Career))
+			}
+			fmt.Printf("Successfully saved %d coaches.\n", len(coaches.Response))
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) FetchTrophies() *cobra.Command {
+	return &cobra.Command{
+		Use: "fetch-trophies",
+		Short: "Fetch and save trophies of every stored team player and coach (run fetch-squad or fetch-player-stats and fetch-coaches first)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			players, err := c.Service.SavePlayerTrophies(cmd.Context(), c.teamID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Successfully saved trophies for %d players.\n", len(players))
+
+			coaches, err := c.Service.SaveCoachTrophies(cmd.Context(), c.teamID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Successfully saved trophies for %d coaches.\n", len(coaches))
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) FetchSidelined() *cobra.Command {
+	return &cobra.Command{
+		Use: "fetch-sidelined",
+		Short: "Fetch and save injury and suspension periods of every stored team player (run fetch-squad or fetch-player-stats first)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sidelined, err := c.Service.SaveSidelined(cmd.Context(), c.teamID)
+			if err != nil {
+				return err
+			}
+
+			periods := 0
+			for _, player := range sidelined {
+				periods += len(player.Response)
+			}
+
+			fmt.Printf("Successfully saved %d sidelined periods for %d players.\n", periods, len(sidelined))
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) FetchHeadToHead() *cobra.Command {
+	var opponentID int
+
+	cmd := &cobra.Command{
+		Use: "fetch-h2h",
+		Short: "Fetch and save every meeting between the team and an opponent across all competitions and seasons",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetings, err := c.Service.SaveHeadToHead(cmd.Context(), c.teamID, opponentID)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Successfully saved %d meetings with team %d.\n", len(meetings.Response), opponentID)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&opponentID, "opponent", 0, "API-Football team ID of the opponent")
+	cmd.MarkFlagRequired("opponent")
+
+	return cmd
+}
+
+
+func (c *CLI) ListTrackedTeams() *cobra.Command {
+	return &cobra.Command{
+		Use: "list-tracked-teams",
+		Short: "List all teams in the tracked teams registry",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			teams, err := c.Service.GetTrackedTeams(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, t := range teams {
+				marker := ""
+				if t.IsDefault {
+					marker = " (default)"
+				}
+				fmt.Printf("%d %s - league %d %s%s\n", t.TeamID, t.TeamName, t.LeagueID, t.LeagueName, marker)
 			}
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) TrackTeam() *cobra.Command {
+	var team model.TrackedTeamDTO
 
+	cmd := &cobra.Command{
+		Use: "track-team",
+		Short: "Add or update a team in the tracked teams registry",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			team.TeamID = c.teamID
+
+			tracked, err := c.Service.TrackTeam(cmd.Context(), team)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Tracking %s (%d) in league %d.\n", tracked.TeamName, tracked.TeamID, tracked.LeagueID)
 			return nil
 		},
 	}
-}
\ No newline at end of file
+
+	cmd.Flags().IntVar(&team.LeagueID, "league", 0, "API-Football league ID the team is tracked in")
+	cmd.Flags().StringVar(&team.TeamName, "name", "", "team name")
+	cmd.Flags().StringVar(&team.LeagueName, "league-name", "", "league name")
+	cmd.Flags().StringVar(&team.Country, "country", "", "country used for venue imports")
+	cmd.Flags().BoolVar(&team.IsDefault, "default", false, "make this the default team")
+
+	return cmd
+}
+
+
+func (c *CLI) Quota() *cobra.Command {
+	return &cobra.Command{
+		Use: "quota",
+		Short: "Show the last recorded API-Football request quota",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := c.Service.GetAPIQuota(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Daily: %d/%d remaining, recorded at %s\n", quota.DailyRemaining, quota.DailyLimit, quota.DailyUpdatedAt.Format(time.RFC3339))
+			fmt.Printf("Per minute: %d/%d remaining\n", quota.MinuteRemaining, quota.MinuteLimit)
+			fmt.Printf("Recorded at: %s\n", quota.UpdatedAt.Format(time.RFC3339))
+			return nil
+		},
+	}
+}
+
+
+func (c *CLI) MockProvider() *cobra.Command {
+	var (
+		addr	string
+		seed	uint64
+	)
+
+	cmd := &cobra.Command{
+		Use: "mock-provider",
+		Short: "Serve deterministic fake API-Football data for local development",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			server := &http.Server{
+				Addr: 		addr,
+				Handler: 	mock_provider.NewServer(seed),
+			}
+
+			go func() {
+				<-cmd.Context().Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5 * time.Second)
+				defer cancel()
+				server.Shutdown(shutdownCtx)
+			}()
+
+			log.Printf("Mock API-Football provider listening on %s (seed %d), set BASE_URL=http://localhost%s", addr, seed, addr)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", ":8090", "address to listen on")
+	cmd.Flags().Uint64Var(&seed, "seed", 1, "seed for the generated data, the same seed always serves the same responses")
+
+	return cmd
+}
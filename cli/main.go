@@ -1,9 +1,33 @@
 package main
 
-import "github.com/deikioveca/TheRedDevilsData/cli/app"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/deikioveca/TheRedDevilsData/api/football_client"
+	"github.com/deikioveca/TheRedDevilsData/cli/app"
+)
 
 func main() {
 	cli 	:= app.NewCLI()
 	root 	:= cli.RootCmd()
-	root.Execute()
-}
\ No newline at end of file
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := root.ExecuteContext(ctx); err != nil {
+		switch {
+		case errors.Is(err, football_client.ErrInvalidAPIKey):
+			fmt.Fprintln(os.Stderr, "hint: check API_KEY in your .env file")
+		case errors.Is(err, football_client.ErrPlanLimit):
+			fmt.Fprintln(os.Stderr, "hint: your API-Football plan does not cover the requested seasons or endpoint")
+		case errors.Is(err, football_client.ErrDailyLimitReached), errors.Is(err, football_client.ErrDailyQuotaExhausted):
+			fmt.Fprintln(os.Stderr, "hint: daily API-Football quota is used up, run the command again after midnight UTC")
+		}
+		stop()
+		os.Exit(1)
+	}
+}